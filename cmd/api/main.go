@@ -1,4 +1,267 @@
 package main
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"product-services/internal/config"
+	"product-services/internal/handlers"
+	"product-services/internal/interfaces"
+	"product-services/internal/logger"
+	"product-services/internal/metrics"
+	"product-services/internal/middleware"
+	"product-services/internal/repository/memory"
+	"product-services/internal/repository/postgres"
+	"product-services/internal/server"
+	"product-services/internal/tracing"
+	"product-services/internal/util"
+
+	"go.opentelemetry.io/otel"
+)
+
+const serviceName = "ProductService"
+
 func main() {
+	cfg, cfgErr := config.Load(os.Getenv("CONFIG_FILE"), os.LookupEnv)
+
+	appLogger := logger.NewLogger(cfg.AppEnv, serviceName, os.Stdout)
+	if cfgErr != nil {
+		appLogger.Fatal(cfgErr, "Invalid configuration")
+	}
+	if cfg.LogLevel != "" {
+		level, err := handlers.ParseLogLevel(cfg.LogLevel)
+		if err != nil {
+			appLogger.Fatal(err, "Invalid LOG_LEVEL")
+		}
+		appLogger.SetLevel(level)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(appLogger, cfg.Database, os.Args[2:]); err != nil {
+			appLogger.Fatal(err, "Migration failed")
+		}
+		return
+	}
+
+	if err := run(appLogger, cfg); err != nil {
+		appLogger.Fatal(err, "Server terminated")
+	}
+}
+
+func run(appLogger interfaces.AppLogger, cfg config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tracerProvider, shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: serviceName,
+		Exporter:    cfg.Tracing.Exporter,
+		FilePath:    cfg.Tracing.File,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			zlog := appLogger.Logger()
+			zlog.Err(err).Msg("Failed to shut down tracing")
+		}
+	}()
+
+	appMetrics := metrics.New()
+	repos, err := newRepositories(ctx, cfg.Database, appMetrics)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := repos.close(); err != nil {
+			zlog := appLogger.Logger()
+			zlog.Err(err).Msg("Failed to close repositories")
+		}
+	}()
+	systemUtil := util.NewSystemUtil()
+	categoryRepo := metrics.InstrumentCategoryRepository(
+		tracing.InstrumentCategoryRepository(repos.category, tracerProvider),
+		appMetrics,
+		systemUtil,
+	)
+	productRepo := metrics.InstrumentProductRepository(
+		tracing.InstrumentProductRepository(repos.product, tracerProvider),
+		appMetrics,
+		systemUtil,
+	)
+
+	validate := handlers.NewValidator()
+
+	categoryHandler := handlers.NewCategoryHandler(
+		categoryRepo,
+		systemUtil,
+		appLogger,
+		validate,
+		cfg.HTTP.HandlerTimeout,
+		cfg.Limits.Category.Bounds(),
+	)
+	productHandler := handlers.NewProductHandler(
+		productRepo,
+		categoryRepo,
+		systemUtil,
+		appLogger,
+		validate,
+		cfg.HTTP.HandlerTimeout,
+		cfg.Limits.Product.Bounds(),
+	)
+
+	var adminHandler *handlers.AdminHandler
+	if cfg.AdminToken != "" {
+		adminHandler = handlers.NewAdminHandler(appLogger, cfg.AdminToken)
+	}
+
+	healthHandler := handlers.NewHealthHandler(
+		[]handlers.Dependency{{Name: repos.backend, Ping: repos.ping}},
+		appLogger,
+		cfg.HTTP.HandlerTimeout,
+	)
+
+	mux := server.NewRouter(categoryHandler, productHandler, adminHandler, healthHandler, appMetrics.Handler())
+	handler := middleware.Chain(
+		mux,
+		middleware.RequestID(appLogger, systemUtil),
+		func(next http.Handler) http.Handler { return handlers.WithErrorFormat(next, cfg.ErrorFormat) },
+		middleware.Route(mux, appLogger),
+		middleware.Tracing(tracerProvider, otel.GetTextMapPropagator(), appLogger),
+		middleware.Metrics(appMetrics, systemUtil),
+		middleware.AccessLog(appLogger, systemUtil, middleware.AccessLogConfig{
+			SampleSuccessEvery: cfg.AccessLog.SampleSuccessEvery,
+		}),
+		middleware.Recover(appLogger),
+	)
+
+	srv := server.NewServer(
+		server.Config{
+			Addr:            cfg.HTTP.Addr,
+			ReadTimeout:     cfg.HTTP.ReadTimeout,
+			WriteTimeout:    cfg.HTTP.WriteTimeout,
+			IdleTimeout:     cfg.HTTP.IdleTimeout,
+			ShutdownTimeout: cfg.HTTP.ShutdownTimeout,
+			ShutdownDelay:   cfg.HTTP.ShutdownDelay,
+		},
+		handler,
+		appLogger,
+	)
+	srv.OnShutdown(healthHandler.SetShuttingDown)
+
+	return srv.Run(ctx)
+}
+
+// runMigrate executes the `migrate` subcommand:
+//
+//	migrate up            apply all pending migrations
+//	migrate down          roll back the latest migration
+//	migrate to <version>  migrate up or down to version (0 rolls back everything)
+//	migrate version       print the current schema version
+func runMigrate(appLogger interfaces.AppLogger, dbConfig config.DatabaseConfig, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down|to <version>|version")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	db, err := postgres.Open(ctx, postgres.Config{
+		DSN:          dbConfig.URL,
+		MaxOpenConns: 1,
+		MaxIdleConns: 1,
+	})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := postgres.NewMigrator(db, postgres.Migrations, appLogger)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		return migrator.Down(ctx)
+	case "to":
+		if len(args) != 2 {
+			return errors.New("usage: migrate to <version>")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid migration version: `%s`, error: %w", args[1], err)
+		}
+		return migrator.To(ctx, version)
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		zlog := appLogger.Logger()
+		zlog.Info().Int64("version", version).Msg("Current schema version")
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command: `%s`", args[0])
+	}
+}
+
+type repositories struct {
+	backend  string
+	category interfaces.CategoryRepository
+	product  interfaces.ProductRepository
+	ping     func(ctx context.Context) error
+	close    func() error
+}
+
+// newRepositories returns the repositories for the configured storage
+// backend and registers the connection pool statistics of the database, if
+// any, in m.
+func newRepositories(ctx context.Context, dbConfig config.DatabaseConfig, m *metrics.Metrics) (*repositories, error) {
+	backend := dbConfig.Backend
+	switch backend {
+	case "postgres":
+		db, err := postgres.Open(ctx, postgres.Config{
+			DSN:             dbConfig.URL,
+			MaxOpenConns:    dbConfig.MaxOpenConns,
+			MaxIdleConns:    dbConfig.MaxIdleConns,
+			ConnMaxLifetime: dbConfig.ConnMaxLifetime,
+			ConnMaxIdleTime: dbConfig.ConnMaxIdleTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := m.RegisterDBStats(db, "products"); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+		return &repositories{
+			backend:  backend,
+			category: postgres.NewCategoryRepository(db),
+			product:  postgres.NewProductRepository(db),
+			ping:     db.PingContext,
+			close:    db.Close,
+		}, nil
+	case "memory":
+		store := memory.NewStore()
+		return &repositories{
+			backend:  backend,
+			category: memory.NewCategoryRepository(store),
+			product:  memory.NewProductRepository(store),
+			ping:     func(context.Context) error { return nil },
+			close:    func() error { return nil },
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported repository backend: `%s`", backend)
+	}
 }
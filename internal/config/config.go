@@ -0,0 +1,264 @@
+// Package config loads the typed configuration of the service from its
+// defaults, an optional YAML file and environment variables, in increasing
+// order of precedence.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"product-services/internal/handlers"
+	"product-services/internal/middleware"
+	"product-services/internal/repository/postgres"
+	"product-services/internal/server"
+	"product-services/internal/tracing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultAppEnv            = "development"
+	DefaultRepositoryBackend = "postgres"
+	DefaultHandlerTimeout    = 5 * time.Second
+)
+
+// Config is the configuration of the service. The `env` tags name the
+// environment variables overriding each field; the tag of a nested struct
+// prefixes the variables of its fields.
+type Config struct {
+	AppEnv      string               `yaml:"app_env" json:"app_env" env:"APP_ENV" validate:"required"`
+	LogLevel    string               `yaml:"log_level" json:"log_level" env:"LOG_LEVEL"`
+	ErrorFormat handlers.ErrorFormat `yaml:"error_format" json:"error_format" env:"ERROR_FORMAT"`
+	AdminToken  string               `yaml:"admin_token" json:"admin_token" env:"ADMIN_TOKEN"`
+	HTTP        HTTPConfig           `yaml:"http" json:"http"`
+	Database    DatabaseConfig       `yaml:"database" json:"database"`
+	Limits      LimitsConfig         `yaml:"limits" json:"limits"`
+	AccessLog   AccessLogConfig      `yaml:"access_log" json:"access_log"`
+	Tracing     TracingConfig        `yaml:"tracing" json:"tracing"`
+}
+
+// HTTPConfig configures the HTTP server and the handlers.
+type HTTPConfig struct {
+	Addr            string        `yaml:"addr" json:"addr" env:"HTTP_ADDR" validate:"required"`
+	ReadTimeout     time.Duration `yaml:"read_timeout" json:"read_timeout" env:"HTTP_READ_TIMEOUT" validate:"gt=0"`
+	WriteTimeout    time.Duration `yaml:"write_timeout" json:"write_timeout" env:"HTTP_WRITE_TIMEOUT" validate:"gt=0"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout" json:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" validate:"gt=0"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" json:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" validate:"gt=0"`
+	ShutdownDelay   time.Duration `yaml:"shutdown_delay" json:"shutdown_delay" env:"SHUTDOWN_DELAY" validate:"gte=0"`
+	HandlerTimeout  time.Duration `yaml:"handler_timeout" json:"handler_timeout" env:"HANDLER_TIMEOUT" validate:"gt=0"`
+}
+
+// DatabaseConfig selects the repository backend and configures the
+// PostgreSQL connection pool. A MaxIdleConns set in the file or the
+// environment must not exceed MaxOpenConns; left at its default, database/sql
+// caps it at MaxOpenConns.
+type DatabaseConfig struct {
+	Backend         string        `yaml:"backend" json:"backend" env:"REPOSITORY_BACKEND" validate:"oneof=postgres memory"`
+	URL             string        `yaml:"url" json:"url" env:"DATABASE_URL" validate:"required_if=Backend postgres"`
+	MaxOpenConns    int           `yaml:"max_open_conns" json:"max_open_conns" env:"DB_MAX_OPEN_CONNS" validate:"gte=1"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" json:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" validate:"gte=0"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" json:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" validate:"gte=0"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" json:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME" validate:"gte=0"`
+}
+
+// LimitsConfig holds the page sizes accepted by the list endpoints.
+type LimitsConfig struct {
+	Category LimitConfig `yaml:"category" json:"category" env:"CATEGORY_LIMIT"`
+	Product  LimitConfig `yaml:"product" json:"product" env:"PRODUCT_LIMIT"`
+}
+
+// LimitConfig holds the page sizes accepted by a list endpoint.
+type LimitConfig struct {
+	Min     int `yaml:"min" json:"min" env:"MIN"`
+	Max     int `yaml:"max" json:"max" env:"MAX"`
+	Default int `yaml:"default" json:"default" env:"DEFAULT"`
+}
+
+// Bounds returns the limits as handlers.LimitBounds.
+func (c LimitConfig) Bounds() handlers.LimitBounds {
+	return handlers.LimitBounds{Min: c.Min, Max: c.Max, Default: c.Default}
+}
+
+// AccessLogConfig configures the access log middleware.
+type AccessLogConfig struct {
+	SampleSuccessEvery uint32 `yaml:"sample_success_every" json:"sample_success_every" env:"ACCESS_LOG_SAMPLE_EVERY"`
+}
+
+// TracingConfig selects the trace exporter.
+type TracingConfig struct {
+	Exporter tracing.Exporter `yaml:"exporter" json:"exporter" env:"TRACE_EXPORTER"`
+	File     string           `yaml:"file" json:"file" env:"TRACE_FILE"`
+}
+
+// Default returns the configuration used for the settings that are neither
+// in the file nor in the environment.
+func Default() Config {
+	limits := LimitConfig{
+		Min:     handlers.DefaultLimitBounds.Min,
+		Max:     handlers.DefaultLimitBounds.Max,
+		Default: handlers.DefaultLimitBounds.Default,
+	}
+	return Config{
+		AppEnv:      DefaultAppEnv,
+		ErrorFormat: handlers.ErrorFormatJSON,
+		HTTP: HTTPConfig{
+			Addr:            server.DefaultAddr,
+			ReadTimeout:     server.DefaultReadTimeout,
+			WriteTimeout:    server.DefaultWriteTimeout,
+			IdleTimeout:     server.DefaultIdleTimeout,
+			ShutdownTimeout: server.DefaultShutdownTimeout,
+			ShutdownDelay:   server.DefaultShutdownDelay,
+			HandlerTimeout:  DefaultHandlerTimeout,
+		},
+		Database: DatabaseConfig{
+			Backend:         DefaultRepositoryBackend,
+			MaxOpenConns:    postgres.DefaultMaxOpenConns,
+			MaxIdleConns:    postgres.DefaultMaxIdleConns,
+			ConnMaxLifetime: postgres.DefaultConnMaxLifetime,
+			ConnMaxIdleTime: postgres.DefaultConnMaxIdleTime,
+		},
+		Limits: LimitsConfig{
+			Category: limits,
+			Product:  limits,
+		},
+		AccessLog: AccessLogConfig{
+			SampleSuccessEvery: middleware.DefaultAccessLogConfig.SampleSuccessEvery,
+		},
+		Tracing: TracingConfig{
+			Exporter: tracing.ExporterNone,
+		},
+	}
+}
+
+// LookupFunc looks up an environment variable, such as os.LookupEnv.
+type LookupFunc func(key string) (string, bool)
+
+// Load returns the configuration read from the YAML file at path, if not
+// empty, and the environment variables found by lookup. Every invalid
+// setting is reported in the returned error, in which case the Config holds
+// the valid settings and the defaults of the others.
+func Load(path string, lookup LookupFunc) (Config, error) {
+	cfg := Default()
+
+	var errs []error
+	set := make(map[string]bool)
+	if path != "" {
+		if err := loadFile(path, &cfg, set); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	envKeys, envErrs := loadEnv(&cfg, lookup, set)
+	errs = append(errs, envErrs...)
+	cfg.LogLevel = strings.ToLower(strings.TrimSpace(cfg.LogLevel))
+
+	errs = append(errs, validate(cfg, envKeys, set)...)
+	if len(errs) > 0 {
+		return cfg, fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return cfg, nil
+}
+
+// loadFile decodes the YAML file at path into cfg, rejecting unknown keys,
+// and marks the path of every setting it holds in set.
+func loadFile(path string, cfg *Config, set map[string]bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: `%s`, error: %w", path, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file: `%s`, error: %w", path, err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("invalid config file: `%s`, error: %w", path, err)
+	}
+	for _, node := range document.Content {
+		markSettings(node, "", set)
+	}
+	return nil
+}
+
+// markSettings marks in set the path of every scalar setting under the YAML
+// node found at path.
+func markSettings(node *yaml.Node, path string, set map[string]bool) {
+	if node.Kind != yaml.MappingNode {
+		set[path] = true
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		markSettings(node.Content[i+1], joinNonEmpty(".", path, node.Content[i].Value), set)
+	}
+}
+
+// validate checks the log level, error format and trace exporter with their
+// parsers, cfg against its `validate` tags, the idle connections against the
+// open ones when they are in set, and the limits against
+// handlers.LimitBounds.Validate. Failures name the field and the environment
+// variable setting it.
+func validate(cfg Config, envKeys map[string]string, set map[string]bool) []error {
+	var errs []error
+	for _, setting := range []struct {
+		path  string
+		parse func() error
+	}{
+		{path: "log_level", parse: func() error {
+			if cfg.LogLevel == "" {
+				return nil
+			}
+			_, err := handlers.ParseLogLevel(cfg.LogLevel)
+			return err
+		}},
+		{path: "error_format", parse: func() error {
+			_, err := handlers.ParseErrorFormat(string(cfg.ErrorFormat))
+			return err
+		}},
+		{path: "tracing.exporter", parse: func() error {
+			_, err := tracing.ParseExporter(string(cfg.Tracing.Exporter))
+			return err
+		}},
+	} {
+		if err := setting.parse(); err != nil {
+			errs = append(errs, fmt.Errorf("%s (%s): %w", setting.path, envKeys[setting.path], err))
+		}
+	}
+
+	fieldErrs, err := handlers.NewValidator().Validate(cfg)
+	if err != nil && len(fieldErrs) == 0 {
+		errs = append(errs, err)
+	}
+	for _, fe := range fieldErrs {
+		errs = append(errs, fmt.Errorf("%s (%s): %s", fe.Field, envKeys[fe.Field], fe.Message))
+	}
+
+	const maxIdleConns = "database.max_idle_conns"
+	db := cfg.Database
+	if set[maxIdleConns] && db.MaxOpenConns >= 1 && db.MaxIdleConns > db.MaxOpenConns {
+		errs = append(errs, fmt.Errorf(
+			"%s (%s): max_idle_conns must not exceed max_open_conns (%d), got %d",
+			maxIdleConns, envKeys[maxIdleConns], db.MaxOpenConns, db.MaxIdleConns,
+		))
+	}
+
+	for _, limits := range []struct {
+		name   string
+		config LimitConfig
+	}{
+		{name: "limits.category", config: cfg.Limits.Category},
+		{name: "limits.product", config: cfg.Limits.Product},
+	} {
+		if err := limits.config.Bounds().Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", limits.name, err))
+		}
+	}
+	return errs
+}
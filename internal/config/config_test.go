@@ -0,0 +1,177 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"product-services/internal/handlers"
+	"product-services/internal/tracing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupFrom(env map[string]string) LookupFunc {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should use the defaults if nothing is set", func(t *testing.T) {
+		cfg, err := Load("", lookupFrom(map[string]string{"REPOSITORY_BACKEND": "memory"}))
+
+		require.NoError(t, err)
+		expected := Default()
+		expected.Database.Backend = "memory"
+		assert.Equal(t, expected, cfg)
+	})
+
+	t.Run("should override the defaults with the environment", func(t *testing.T) {
+		cfg, err := Load("", lookupFrom(map[string]string{
+			"APP_ENV":                 "production",
+			"LOG_LEVEL":               "WARN",
+			"ERROR_FORMAT":            "problem",
+			"HANDLER_TIMEOUT":         "2s",
+			"DATABASE_URL":            "postgres://localhost/products",
+			"DB_MAX_OPEN_CONNS":       "10",
+			"DB_MAX_IDLE_CONNS":       "5",
+			"PRODUCT_LIMIT_MAX":       "50",
+			"ACCESS_LOG_SAMPLE_EVERY": "10",
+			"TRACE_EXPORTER":          "stdout",
+			"HTTP_ADDR":               "",
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "production", cfg.AppEnv)
+		assert.Equal(t, "warn", cfg.LogLevel)
+		assert.Equal(t, handlers.ErrorFormatProblem, cfg.ErrorFormat)
+		assert.Equal(t, 2*time.Second, cfg.HTTP.HandlerTimeout)
+		assert.Equal(t, ":8080", cfg.HTTP.Addr)
+		assert.Equal(t, "postgres://localhost/products", cfg.Database.URL)
+		assert.Equal(t, 10, cfg.Database.MaxOpenConns)
+		assert.Equal(t, 5, cfg.Database.MaxIdleConns)
+		assert.Equal(t, handlers.LimitBounds{Min: 1, Max: 50, Default: 20}, cfg.Limits.Product.Bounds())
+		assert.Equal(t, handlers.DefaultLimitBounds, cfg.Limits.Category.Bounds())
+		assert.Equal(t, uint32(10), cfg.AccessLog.SampleSuccessEvery)
+		assert.Equal(t, tracing.ExporterStdout, cfg.Tracing.Exporter)
+	})
+
+	t.Run("should accept lowering only the open connections", func(t *testing.T) {
+		cfg, err := Load("", lookupFrom(map[string]string{
+			"REPOSITORY_BACKEND": "memory",
+			"DB_MAX_OPEN_CONNS":  "10",
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, cfg.Database.MaxOpenConns)
+		assert.Equal(t, Default().Database.MaxIdleConns, cfg.Database.MaxIdleConns)
+	})
+
+	t.Run("should reject more idle than open connections set in the file", func(t *testing.T) {
+		path := writeFile(t, `
+database:
+  backend: memory
+  max_open_conns: 10
+  max_idle_conns: 20
+`)
+
+		_, err := Load(path, lookupFrom(nil))
+
+		assert.EqualError(t, err, "invalid configuration: "+
+			"database.max_idle_conns (DB_MAX_IDLE_CONNS): max_idle_conns must not exceed max_open_conns (10), got 20")
+	})
+
+	t.Run("should only report invalid open connections", func(t *testing.T) {
+		_, err := Load("", lookupFrom(map[string]string{
+			"REPOSITORY_BACKEND": "memory",
+			"DB_MAX_OPEN_CONNS":  "0",
+			"DB_MAX_IDLE_CONNS":  "5",
+		}))
+
+		assert.EqualError(t, err, "invalid configuration: "+
+			"database.max_open_conns (DB_MAX_OPEN_CONNS): max_open_conns must be 1 or greater")
+	})
+
+	t.Run("should read the file and let the environment override it", func(t *testing.T) {
+		path := writeFile(t, `
+app_env: staging
+http:
+  addr: ":9090"
+  handler_timeout: 3s
+database:
+  backend: memory
+limits:
+  category:
+    max: 40
+    default: 10
+tracing:
+  exporter: otlp
+`)
+
+		cfg, err := Load(path, lookupFrom(map[string]string{"HTTP_ADDR": ":7070"}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "staging", cfg.AppEnv)
+		assert.Equal(t, ":7070", cfg.HTTP.Addr)
+		assert.Equal(t, 3*time.Second, cfg.HTTP.HandlerTimeout)
+		assert.Equal(t, Default().HTTP.ReadTimeout, cfg.HTTP.ReadTimeout)
+		assert.Equal(t, "memory", cfg.Database.Backend)
+		assert.Equal(t, handlers.LimitBounds{Min: 1, Max: 40, Default: 10}, cfg.Limits.Category.Bounds())
+		assert.Equal(t, tracing.ExporterOTLP, cfg.Tracing.Exporter)
+	})
+
+	t.Run("should accept an empty file", func(t *testing.T) {
+		cfg, err := Load(writeFile(t, ""), lookupFrom(map[string]string{"REPOSITORY_BACKEND": "memory"}))
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultAppEnv, cfg.AppEnv)
+	})
+
+	t.Run("should reject unknown keys and missing files", func(t *testing.T) {
+		_, err := Load(writeFile(t, "database:\n  dsn: postgres://localhost\n"), lookupFrom(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field dsn not found")
+
+		_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"), lookupFrom(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to open config file")
+	})
+
+	t.Run("should report every invalid setting", func(t *testing.T) {
+		_, err := Load("", lookupFrom(map[string]string{
+			"LOG_LEVEL":             "loud",
+			"ERROR_FORMAT":          "xml",
+			"HANDLER_TIMEOUT":       "0s",
+			"SHUTDOWN_DELAY":        "soon",
+			"DB_MAX_IDLE_CONNS":     "50",
+			"CATEGORY_LIMIT_MAX":    "many",
+			"PRODUCT_LIMIT_DEFAULT": "500",
+			"TRACE_EXPORTER":        "jaeger",
+		}))
+
+		require.Error(t, err)
+		expected := "invalid configuration: " +
+			"invalid SHUTDOWN_DELAY: `soon`, error: time: invalid duration \"soon\"\n" +
+			"invalid CATEGORY_LIMIT_MAX: `many`, error: strconv.ParseInt: parsing \"many\": invalid syntax\n" +
+			"log_level (LOG_LEVEL): invalid level value: `loud`, must be one of trace, debug, info, warn, error, fatal, panic or disabled\n" +
+			"error_format (ERROR_FORMAT): unsupported error format: `xml`, must be json or problem\n" +
+			"tracing.exporter (TRACE_EXPORTER): unsupported trace exporter: `jaeger`, must be none, stdout or otlp\n" +
+			"http.handler_timeout (HANDLER_TIMEOUT): handler_timeout must be greater than 0\n" +
+			"database.url (DATABASE_URL): url is a required field\n" +
+			"database.max_idle_conns (DB_MAX_IDLE_CONNS): max_idle_conns must not exceed max_open_conns (25), got 50\n" +
+			"limits.product: default limit 500 is outside [1, 100]"
+		assert.EqualError(t, err, expected)
+	})
+}
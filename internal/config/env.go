@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// loadEnv overrides the fields of cfg with the environment variables named
+// by their `env` tags. Empty variables are ignored. It returns the variable
+// of each field keyed by its JSON path, as reported by the validator, and
+// marks the path of every field it sets in set.
+func loadEnv(cfg *Config, lookup LookupFunc, set map[string]bool) (map[string]string, []error) {
+	keys := make(map[string]string)
+	errs := loadEnvStruct(reflect.ValueOf(cfg).Elem(), "", "", lookup, keys, set)
+	return keys, errs
+}
+
+func loadEnvStruct(
+	v reflect.Value,
+	prefix string,
+	path string,
+	lookup LookupFunc,
+	keys map[string]string,
+	set map[string]bool,
+) []error {
+	var errs []error
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		fieldPath := joinNonEmpty(".", path, name)
+		key := joinNonEmpty("_", prefix, field.Tag.Get("env"))
+
+		if field.Type.Kind() == reflect.Struct {
+			errs = append(errs, loadEnvStruct(v.Field(i), key, fieldPath, lookup, keys, set)...)
+			continue
+		}
+		if field.Tag.Get("env") == "" {
+			continue
+		}
+
+		keys[fieldPath] = key
+		value, ok := lookup(key)
+		if !ok || value == "" {
+			continue
+		}
+		if err := setField(v.Field(i), value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: `%s`, error: %w", key, value, err))
+			continue
+		}
+		set[fieldPath] = true
+	}
+	return errs
+}
+
+// setField parses value into the string, integer or duration field v.
+func setField(v reflect.Value, value string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type: `%s`", v.Type())
+	}
+	return nil
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
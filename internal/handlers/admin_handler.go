@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"product-services/internal/interfaces"
+
+	"github.com/rs/zerolog"
+)
+
+// LogLevel is the body of the log level admin endpoints.
+type LogLevel struct {
+	Level string `json:"level"`
+}
+
+// AdminHandler serves the operational endpoints. Every request must carry
+// the admin token as a bearer token.
+type AdminHandler struct {
+	logger interfaces.AppLogger
+	token  string
+}
+
+func NewAdminHandler(logger interfaces.AppLogger, token string) *AdminHandler {
+	return &AdminHandler{
+		logger: logger,
+		token:  token,
+	}
+}
+
+func (h *AdminHandler) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	const op = "AdminHandler.GetLogLevel"
+	if !AuthorizeAdmin(r, h.token, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusUnauthorized,
+			ErrMessageUnauthorized,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully fetched log level",
+		LogLevel{Level: h.logger.Level().String()},
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+// SetLogLevel changes the level of the application logger without a
+// restart.
+func (h *AdminHandler) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	const op = "AdminHandler.SetLogLevel"
+	if !AuthorizeAdmin(r, h.token, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusUnauthorized,
+			ErrMessageUnauthorized,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	var req LogLevel
+	if !ParseRequestBody(w, r, &req, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	level, details, isValid := ParseAndValidateLogLevel(r.Context(), req.Level, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			details,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	SetLogLevel(r.Context(), h.logger, level, op)
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully updated log level",
+		LogLevel{Level: level.String()},
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+// AuthorizeAdmin reports whether r carries token as its bearer token and
+// logs rejected requests. An empty token rejects every request.
+func AuthorizeAdmin(
+	r *http.Request,
+	token string,
+	op string,
+	logger interfaces.AppLogger,
+) bool {
+	bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if found && token != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+		return true
+	}
+
+	appLogger := logger.FromContext(r.Context())
+	appLogger.Err(errors.New("missing or invalid admin token")).
+		Str("op", op).
+		Int("code", ErrCodeUnauthorized).
+		Msg(ErrMessageUnauthorized)
+	return false
+}
+
+// ParseLogLevel parses a zerolog level name such as `debug` or `info`.
+func ParseLogLevel(s string) (zerolog.Level, error) {
+	level, err := zerolog.ParseLevel(strings.ToLower(strings.TrimSpace(s)))
+	if err != nil || level == zerolog.NoLevel {
+		return zerolog.NoLevel, fmt.Errorf(
+			"invalid level value: `%s`, must be one of trace, debug, info, warn, error, fatal, panic or disabled", s,
+		)
+	}
+	return level, nil
+}
+
+// ParseAndValidateLogLevel parses s with ParseLogLevel. When it is invalid
+// it logs the error and reports false together with the details to include
+// in the error response.
+func ParseAndValidateLogLevel(
+	ctx context.Context,
+	s string,
+	op string,
+	logger interfaces.AppLogger,
+) (zerolog.Level, any, bool) {
+	level, err := ParseLogLevel(s)
+	if err != nil {
+		appLogger := logger.FromContext(ctx)
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestBody).
+			Msg(ErrMessageInvalidRequestBody)
+		return zerolog.NoLevel, err.Error(), false
+	}
+	return level, nil, true
+}
+
+// SetLogLevel changes the level of logger and records the change at the
+// level that is least likely to be filtered out.
+func SetLogLevel(ctx context.Context, logger interfaces.AppLogger, level zerolog.Level, op string) {
+	previous := logger.Level()
+	logger.SetLevel(level)
+
+	appLogger := logger.FromContext(ctx)
+	appLogger.WithLevel(zerolog.NoLevel).
+		Str("op", op).
+		Str("previous_log_level", previous.String()).
+		Str("log_level", level.String()).
+		Msg("Log level changed")
+}
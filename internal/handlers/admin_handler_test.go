@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"product-services/internal/logger"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminToken = "s3cret"
+
+func TestGetLogLevel(t *testing.T) {
+	t.Run("should respond with unauthorized if the token is wrong", func(t *testing.T) {
+		for _, authorization := range []string{"", "Bearer wrong", "s3cret"} {
+			var logBuf bytes.Buffer
+			logger := logger.NewLogger(env, service, &logBuf)
+			h := NewAdminHandler(logger, adminToken)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/log-level", http.NoBody)
+			req.Header.Set("Authorization", authorization)
+			rw := httptest.NewRecorder()
+
+			h.GetLogLevel(rw, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rw.Code, authorization)
+			assert.JSONEq(t, `{"status":"error","error":{"message":"Unauthorized"}}`, rw.Body.String())
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+			assert.Equal(t, float64(ErrCodeUnauthorized), entry["code"])
+			assert.Contains(t, entry["caller"], "internal/handlers/admin_handler.go")
+		}
+	})
+
+	t.Run("should respond with the current log level", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewAdminHandler(logger, adminToken)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/log-level", http.NoBody)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rw := httptest.NewRecorder()
+
+		h.GetLogLevel(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"status": "success",
+			"message": "Successfully fetched log level",
+			"data": {"level": "info"}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+}
+
+func TestSetLogLevel(t *testing.T) {
+	t.Run("should change the log level at runtime", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		h := NewAdminHandler(appLogger, adminToken)
+
+		req := httptest.NewRequest(http.MethodPut, "/admin/log-level", strings.NewReader(`{"level": "DEBUG"}`))
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rw := httptest.NewRecorder()
+
+		h.SetLogLevel(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"status": "success",
+			"message": "Successfully updated log level",
+			"data": {"level": "debug"}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, zerolog.DebugLevel, appLogger.Level())
+
+		zlog := appLogger.Logger()
+		zlog.Debug().Msg("now visible")
+
+		var entries []map[string]interface{}
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+			entries = append(entries, entry)
+		}
+		require.Len(t, entries, 2)
+		assert.Equal(t, "Log level changed", entries[0]["message"])
+		assert.Equal(t, "info", entries[0]["previous_log_level"])
+		assert.Equal(t, "debug", entries[0]["log_level"])
+		assert.Equal(t, "now visible", entries[1]["message"])
+	})
+
+	t.Run("should respond with bad request if the level is unknown", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		h := NewAdminHandler(appLogger, adminToken)
+
+		req := httptest.NewRequest(http.MethodPut, "/admin/log-level", strings.NewReader(`{"level": "verbose"}`))
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rw := httptest.NewRecorder()
+
+		h.SetLogLevel(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		var response HTTPErrorResponse
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &response))
+		assert.Equal(t,
+			"invalid level value: `verbose`, must be one of trace, debug, info, warn, error, fatal, panic or disabled",
+			response.Error.Details,
+		)
+		assert.Equal(t, zerolog.InfoLevel, appLogger.Level())
+	})
+}
@@ -6,25 +6,25 @@ import (
 	"time"
 
 	"product-services/internal/interfaces"
-	"product-services/internal/shared"
-
-	"github.com/go-playground/validator/v10"
+	"product-services/internal/models"
 )
 
 type CategoryHandler struct {
 	repo       interfaces.CategoryRepository
 	util       interfaces.SystemUtil
 	logger     interfaces.AppLogger
-	validate   *validator.Validate
+	validate   *Validator
 	ctxTimeOut time.Duration
+	limits     LimitBounds
 }
 
 func NewCategoryHandler(
 	repo interfaces.CategoryRepository,
 	util interfaces.SystemUtil,
 	logger interfaces.AppLogger,
-	validate *validator.Validate,
+	validate *Validator,
 	ctxTimeOut time.Duration,
+	limits LimitBounds,
 ) *CategoryHandler {
 	return &CategoryHandler{
 		repo:       repo,
@@ -32,38 +32,56 @@ func NewCategoryHandler(
 		logger:     logger,
 		validate:   validate,
 		ctxTimeOut: ctxTimeOut,
+		limits:     limits,
 	}
 }
 
 func (h *CategoryHandler) ListCategories(w http.ResponseWriter, r *http.Request) {
 	const op = "CategoryHandler.ListCategories"
-	createdAfter, limit, isValid := ParseAndValidatePagination(r, op, h.logger)
+	listOptions, details, isValid := ParseAndValidatePagination(r, h.limits, models.CategorySortFields, op, h.logger)
 	if !isValid {
 		WriteErrorResponse(
 			w,
+			r,
 			http.StatusBadRequest,
 			ErrMessageInvalidRequestParam,
-			nil,
+			details,
 			op,
 			h.logger,
 		)
 		return
 	}
 
-	listOptions := shared.ListOptions{
-		CreatedAfter: createdAfter,
-		Limit:        limit,
-	}
-
 	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
 	defer cancel()
 
 	result, err := h.repo.ListCategories(ctx, listOptions)
 	if err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully fetched list of categories",
+		result.Categories,
+		NewPagination(result.Pagination, listOptions.Limit),
+		op,
+		h.logger,
+	)
+}
+
+func (h *CategoryHandler) GetCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryHandler.GetCategory"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
 		WriteErrorResponse(
 			w,
-			http.StatusInternalServerError,
-			ErrMessageInternalServerError,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
 			nil,
 			op,
 			h.logger,
@@ -71,15 +89,192 @@ func (h *CategoryHandler) ListCategories(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	category, err := h.repo.GetCategoryByID(ctx, id)
+	if err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
 	WriteSuccessResponse(
+		r.Context(),
 		w,
 		http.StatusOK,
-		"Successfully fetched list of categories",
-		result.Categories,
-		&Pagination{
-			HasMore:    result.HasMore,
-			NextCursor: EncodeTimeToCursor(result.NextCursor),
+		"Successfully fetched category",
+		category,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *CategoryHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryHandler.CreateCategory"
+	var req models.CategoryRequest
+	if !ParseRequestBody(w, r, &req, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	fieldErrs, isValid := ValidateRequestBody(r.Context(), h.validate, &req, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageValidationFailed,
+			fieldErrs,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	now := h.util.CurrentTime()
+	category := &models.Category{
+		ID:          h.util.NewUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+		TimeStamps: models.TimeStamps{
+			CreatedAt: now,
+			UpdatedAt: now,
 		},
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if err := h.repo.CreateCategory(ctx, category); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusCreated,
+		"Successfully created category",
+		category,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *CategoryHandler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryHandler.UpdateCategory"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	var req models.CategoryRequest
+	if !ParseRequestBody(w, r, &req, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	fieldErrs, isValid := ValidateRequestBody(r.Context(), h.validate, &req, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageValidationFailed,
+			fieldErrs,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	category := &models.Category{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		TimeStamps: models.TimeStamps{
+			UpdatedAt: h.util.CurrentTime(),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if err := h.repo.UpdateCategory(ctx, category); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully updated category",
+		category,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *CategoryHandler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryHandler.DeleteCategory"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if err := h.repo.DeleteCategory(ctx, id); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully deleted category",
+		nil,
+		nil,
 		op,
 		h.logger,
 	)
@@ -16,10 +16,10 @@ import (
 	"product-services/internal/models"
 	"product-services/internal/shared"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -48,7 +48,12 @@ var (
 )
 
 func TestListCategories(t *testing.T) {
-	createdAfter := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	// legacyCursor is what the timestamp-only cursor `MjAyMy0wMS0wMVQwMDowMDowMFo` decodes to.
+	legacyCursor := &shared.Cursor{
+		SortOrders: []shared.SortOrder{{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc}},
+		Values:     []any{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		ID:         uuid.Max,
+	}
 	const testLimit = 10
 
 	t.Run("should respond with bad request if limit is invalid", func(t *testing.T) {
@@ -57,7 +62,7 @@ func TestListCategories(t *testing.T) {
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		reqURL := "/categories?cursor=MjAyMy0wMS0wMVQwMDowMDowMFo&limit=ss"
 		req := httptest.NewRequest(http.MethodGet, reqURL, strings.NewReader(""))
@@ -69,7 +74,8 @@ func TestListCategories(t *testing.T) {
 		expectedResponse := `{
 			"status":"error",
 			"error": {
-				"message": "Invalid request param"
+				"message": "Invalid request param",
+				"details": "invalid limit value: ` + "`ss`" + `, error: strconv.ParseInt: parsing \"ss\": invalid syntax"
 			}
 		}`
 		assert.JSONEq(t, expectedResponse, rw.Body.String())
@@ -96,13 +102,46 @@ func TestListCategories(t *testing.T) {
 		}
 	})
 
+	t.Run("should respond with bad request if limit is out of range", func(t *testing.T) {
+		for _, limit := range []string{"0", "-5", "101"} {
+			mockRepo := new(mocks.MockCategoryRepository)
+			mockUtil := new(mocks.MockSystemUtil)
+
+			var logBuf bytes.Buffer
+			logger := logger.NewLogger(env, service, &logBuf)
+			h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+			req := httptest.NewRequest(http.MethodGet, "/categories?limit="+limit, http.NoBody)
+			rw := httptest.NewRecorder()
+
+			h.ListCategories(rw, req)
+
+			assert.Equal(t, http.StatusBadRequest, rw.Code, "limit %s", limit)
+			expectedResponse := `{
+				"status":"error",
+				"error": {
+					"message": "Invalid request param",
+					"details": "` + "`limit` must be between 1 and 100, got " + limit + `"
+				}
+			}`
+			assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+			mockRepo.AssertExpectations(t)
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+			assert.Equal(t, float64(1000), entry["code"])
+			assert.Contains(t, entry["caller"], "internal/handlers/category_handler.go")
+		}
+	})
+
 	t.Run("should respond with bad request if cursor is invalid", func(t *testing.T) {
 		mockRepo := new(mocks.MockCategoryRepository)
 		mockUtil := new(mocks.MockSystemUtil)
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		reqURL := "/categories?cursor=MjAyMy0wMS0wMVQ_MDowMDowMFo&limit=ss"
 		req := httptest.NewRequest(http.MethodGet, reqURL, strings.NewReader(""))
@@ -114,7 +153,8 @@ func TestListCategories(t *testing.T) {
 		expectedResponse := `{
 			"status":"error",
 			"error": {
-				"message": "Invalid request param"
+				"message": "Invalid request param",
+				"details": "invalid cursor time format: ` + "`MjAyMy0wMS0wMVQ_MDowMDowMFo`" + `, error: parsing time \"2023-01-01T?0:00:00Z\" as \"2006-01-02T15:04:05.999999999Z07:00\": cannot parse \"?0:00:00Z\" as \"15\""
 			}
 		}`
 		assert.JSONEq(t, expectedResponse, rw.Body.String())
@@ -149,7 +189,7 @@ func TestListCategories(t *testing.T) {
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		reqURL := "/categories?cursor=MjAyMy0wMS0wMVQ<MDowMDowMFo&limit=ss"
 		req := httptest.NewRequest(http.MethodGet, reqURL, strings.NewReader(""))
@@ -161,7 +201,8 @@ func TestListCategories(t *testing.T) {
 		expectedResponse := `{
 			"status":"error",
 			"error": {
-				"message": "Invalid request param"
+				"message": "Invalid request param",
+				"details": "invalid cursor encoding: ` + "`MjAyMy0wMS0wMVQ<MDowMDowMFo`" + `, error: illegal base64 data at input byte 15"
 			}
 		}`
 		assert.JSONEq(t, expectedResponse, rw.Body.String())
@@ -194,12 +235,14 @@ func TestListCategories(t *testing.T) {
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		dbError := errors.New("db query error")
 		listOptions := shared.ListOptions{
-			CreatedAfter: createdAfter,
-			Limit:        testLimit,
+			Cursor:     legacyCursor,
+			Direction:  shared.PageForward,
+			Limit:      testLimit,
+			SortOrders: legacyCursor.SortOrders,
 		}
 		mockRepo.On("ListCategories", mock.Anything, listOptions).
 			Return(&models.ListCategoriesResult{}, dbError)
@@ -240,20 +283,59 @@ func TestListCategories(t *testing.T) {
 		}
 	})
 
+	t.Run("should respond with service unavailable if the database is down", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, appLogger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		dbError := shared.Errorf(shared.KindUnavailable, "connection refused")
+		listOptions := shared.ListOptions{Direction: shared.PageForward, Limit: DefaultLimit}
+		mockRepo.On("ListCategories", mock.Anything, listOptions).
+			Return(&models.ListCategoriesResult{}, dbError)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories", http.NoBody)
+		req = req.WithContext(logger.WithRequestID(req.Context(), "req-123"))
+		rw := httptest.NewRecorder()
+
+		h.ListCategories(rw, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Service Unavailable"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		mockRepo.AssertExpectations(t)
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+		assert.Equal(t, float64(ErrCodeServiceUnavailable), entry["code"])
+		assert.Equal(t, "Service unavailable", entry["message"])
+		assert.Equal(t, "req-123", entry["request_id"])
+		assert.Contains(t, entry["caller"], "internal/handlers/category_handler.go")
+	})
+
 	t.Run("should respond with list of categories if params are valid", func(t *testing.T) {
 		mockRepo := new(mocks.MockCategoryRepository)
 		mockUtil := new(mocks.MockSystemUtil)
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		listCategoriesResult := models.ListCategoriesResult{
 			Categories: []*models.Category{&testCategoryOne, &testCategoryTwo},
 		}
 		listOptions := shared.ListOptions{
-			CreatedAfter: createdAfter,
-			Limit:        testLimit,
+			Cursor:     legacyCursor,
+			Direction:  shared.PageForward,
+			Limit:      testLimit,
+			SortOrders: legacyCursor.SortOrders,
 		}
 		mockRepo.On("ListCategories", mock.Anything, listOptions).
 			Return(&listCategoriesResult, nil)
@@ -280,7 +362,7 @@ func TestListCategories(t *testing.T) {
 			],
 			"message": "Successfully fetched list of categories",
 			"pagination": {
-				"next_cursor": "MDAwMS0wMS0wMVQwMDowMDowMFo"
+				"limit": 10
 			},
 			"status": "success"
 		}`
@@ -297,14 +379,14 @@ func TestListCategories(t *testing.T) {
 
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
-		h := NewCategoryHandler(mockRepo, mockUtil, logger, validator.New(), ctxTimeOut)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
 
 		listCategoriesResult := models.ListCategoriesResult{
 			Categories: []*models.Category{&testCategoryOne, &testCategoryTwo},
 		}
 		listOptions := shared.ListOptions{
-			CreatedAfter: time.Time{},
-			Limit:        20,
+			Direction: shared.PageForward,
+			Limit:     20,
 		}
 		mockRepo.On("ListCategories", mock.Anything, listOptions).
 			Return(&listCategoriesResult, nil)
@@ -331,8 +413,437 @@ func TestListCategories(t *testing.T) {
 			],
 			"message": "Successfully fetched list of categories",
 			"pagination": {
-				"next_cursor": "MDAwMS0wMS0wMVQwMDowMDowMFo"
+				"limit": 20
+			},
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestGetCategory(t *testing.T) {
+	t.Run("should respond with bad request if id is invalid", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/not-a-uuid", http.NoBody)
+		req.SetPathValue(IDParam, "not-a-uuid")
+		rw := httptest.NewRecorder()
+
+		h.GetCategory(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Invalid request param"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "error", entry["level"])
+			assert.Equal(t, "CategoryHandler.GetCategory", entry["op"])
+			assert.Equal(t, float64(1000), entry["code"])
+			errMsg := "invalid id value: `not-a-uuid`, error: invalid UUID length: 10"
+			assert.Equal(t, errMsg, entry["error"])
+			assert.Contains(t, entry["caller"], "internal/handlers/category_handler.go")
+		}
+	})
+
+	t.Run("should respond with not found if category does not exist", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return((*models.Category)(nil), shared.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.GetCategory(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Not Found"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "CategoryHandler.GetCategory", entry["op"])
+			assert.Equal(t, float64(1100), entry["code"])
+			assert.Equal(t, "Resource not found", entry["message"])
+		}
+	})
+
+	t.Run("should respond with category if it exists", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return(&testCategoryOne, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.GetCategory(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"description": "Test category a description",
+				"id": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"name": "Test Category A"
+			},
+			"message": "Successfully fetched category",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestCreateCategory(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should respond with bad request if body is malformed", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		body := `{"name": "Test Category A", "unknown": true}`
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateCategory(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Invalid request body"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "CategoryHandler.CreateCategory", entry["op"])
+			assert.Equal(t, float64(1003), entry["code"])
+			assert.Equal(t, `json: unknown field "unknown"`, entry["error"])
+			assert.Contains(t, entry["caller"], "internal/handlers/category_handler.go")
+		}
+	})
+
+	t.Run("should respond with bad request if validation fails", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		body := `{"name": "ab"}`
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateCategory(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Request validation failed",
+				"details": [{
+					"field": "name",
+					"rule": "min",
+					"param": "3",
+					"message": "name must be at least 3 characters in length"
+				}]
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "CategoryHandler.CreateCategory", entry["op"])
+			assert.Equal(t, float64(1004), entry["code"])
+			assert.Equal(t, "Request validation failed", entry["message"])
+		}
+	})
+
+	t.Run("should respond with conflict if category already exists", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockUtil.On("CurrentTime").Return(now)
+		mockUtil.On("NewUUID").Return(testCategoryOne.ID)
+		mockRepo.On("CreateCategory", mock.Anything, mock.Anything).Return(shared.ErrConflict)
+
+		body := `{"name": "Test Category A"}`
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateCategory(rw, req)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Conflict"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should create category if request is valid", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		expectedCategory := &models.Category{
+			ID:          testCategoryOne.ID,
+			Name:        testCategoryOne.Name,
+			Description: testCategoryOne.Description,
+			TimeStamps: models.TimeStamps{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+		mockUtil.On("CurrentTime").Return(now)
+		mockUtil.On("NewUUID").Return(testCategoryOne.ID)
+		mockRepo.On("CreateCategory", mock.Anything, expectedCategory).Return(nil)
+
+		body := `{"name": "Test Category A", "description": "Test category a description"}`
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateCategory(rw, req)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"description": "Test category a description",
+				"id": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"name": "Test Category A"
 			},
+			"message": "Successfully created category",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestUpdateCategory(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should respond with not found if category does not exist", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockUtil.On("CurrentTime").Return(now)
+		mockRepo.On("UpdateCategory", mock.Anything, mock.Anything).Return(shared.ErrNotFound)
+
+		body := `{"name": "Test Category A"}`
+		req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryOne.ID.String(), strings.NewReader(body))
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.UpdateCategory(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Not Found"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should update category if request is valid", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		expectedCategory := &models.Category{
+			ID:          testCategoryOne.ID,
+			Name:        "Renamed Category",
+			Description: "Renamed description",
+			TimeStamps: models.TimeStamps{
+				UpdatedAt: now,
+			},
+		}
+		mockUtil.On("CurrentTime").Return(now)
+		mockRepo.On("UpdateCategory", mock.Anything, expectedCategory).Return(nil)
+
+		body := `{"name": "Renamed Category", "description": "Renamed description"}`
+		req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryOne.ID.String(), strings.NewReader(body))
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.UpdateCategory(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"description": "Renamed description",
+				"id": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"name": "Renamed Category"
+			},
+			"message": "Successfully updated category",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestDeleteCategory(t *testing.T) {
+	t.Run("should respond with conflict if category is still referenced", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("DeleteCategory", mock.Anything, testCategoryOne.ID).Return(shared.ErrConflict)
+
+		req := httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.DeleteCategory(rw, req)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Conflict"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "CategoryHandler.DeleteCategory", entry["op"])
+			assert.Equal(t, float64(1101), entry["code"])
+			assert.Equal(t, "Resource conflict", entry["message"])
+			assert.Contains(t, entry["caller"], "internal/handlers/category_handler.go")
+		}
+	})
+
+	t.Run("should delete category if it exists", func(t *testing.T) {
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewCategoryHandler(mockRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("DeleteCategory", mock.Anything, testCategoryOne.ID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testCategoryOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.DeleteCategory(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"message": "Successfully deleted category",
 			"status": "success"
 		}`
 		assert.JSONEq(t, expectedResponse, rw.Body.String())
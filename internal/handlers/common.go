@@ -2,37 +2,78 @@ package handlers
 
 import (
 	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
-	"time"
+	"strings"
 
 	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
 )
 
 const (
 	// Defaults
-	DefaultLimit = 20
+	DefaultLimit        = 20
+	MaxRequestBodyBytes = 1 << 20
 
 	// Error codes
 	ErrCodeInvalidRequestParam  = 1000
 	ErrCodeJSONEncoding         = 1001
 	ErrCodeFailedResponseWriter = 1002
+	ErrCodeInvalidRequestBody   = 1003
+	ErrCodeValidationFailed     = 1004
+	ErrCodeInvalidCategoryRef   = 1005
+	ErrCodeUnauthorized         = 1006
+	ErrCodeResourceNotFound     = 1100
+	ErrCodeResourceConflict     = 1101
+	ErrCodeInternal             = 1600
+	ErrCodeServiceUnavailable   = 1601
+	ErrCodeTimeout              = 1602
+	ErrCodePanicRecovered       = 1603
 
 	// Error code messages
 	ErrMessageInvalidRequestParam  = "Invalid request param"
 	ErrMessageJSONEncoding         = "JSON encoding error"
 	ErrMessageFailedResponseWriter = "Failed response writer"
+	ErrMessageInvalidRequestBody   = "Invalid request body"
+	ErrMessageValidationFailed     = "Request validation failed"
+	ErrMessageInvalidCategoryRef   = "Referenced category does not exist"
+	ErrMessageUnauthorized         = "Unauthorized"
+	ErrMessageResourceNotFound     = "Resource not found"
+	ErrMessageResourceConflict     = "Resource conflict"
+	ErrMessageInternal             = "Internal server error"
+	ErrMessageServiceUnavailable   = "Service unavailable"
+	ErrMessageTimeout              = "Operation timed out"
+	ErrMessagePanicRecovered       = "Recovered from panic"
 
 	// http error Messages
 	ErrMessageInternalServerError = "Internal Server Error"
 	ErrMessageBadRequest          = "Bad Request"
+	ErrMessageNotFound            = "Not Found"
+	ErrMessageConflict            = "Conflict"
+	ErrMessageUnavailable         = "Service Unavailable"
+	ErrMessageGatewayTimeout      = "Gateway Timeout"
 
 	// Path params
+	IDParam    = "id"
 	CursorParm = "cursor"
+	BeforeParm = "before"
 	LimitParam = "limit"
+	SortParam  = "sort"
+	QueryParam = "q"
+
+	// Product filter params
+	CategoryIDParam = "category_id"
+	MinPriceParam   = "min_price"
+	MaxPriceParam   = "max_price"
+	InStockParam    = "in_stock"
 
 	StatusSuccess = "success"
 	StatusError   = "error"
@@ -48,9 +89,47 @@ type HTTPErrorResponse struct {
 	Error  Error  `json:"error"`
 }
 
+// LimitBounds configures the page sizes accepted by a list endpoint.
+type LimitBounds struct {
+	Min     int
+	Max     int
+	Default int
+}
+
+// DefaultLimitBounds are the page sizes accepted when a resource does not
+// configure its own.
+var DefaultLimitBounds = LimitBounds{Min: 1, Max: shared.MaxListLimit, Default: DefaultLimit}
+
+// Validate checks that the bounds are consistent and within what the
+// repositories are able to serve.
+func (b LimitBounds) Validate() error {
+	switch {
+	case b.Min < 1:
+		return fmt.Errorf("min limit must be at least 1, got %d", b.Min)
+	case b.Max > shared.MaxListLimit:
+		return fmt.Errorf("max limit must be at most %d, got %d", shared.MaxListLimit, b.Max)
+	case b.Min > b.Max:
+		return fmt.Errorf("min limit %d exceeds max limit %d", b.Min, b.Max)
+	case b.Default < b.Min || b.Default > b.Max:
+		return fmt.Errorf("default limit %d is outside [%d, %d]", b.Default, b.Min, b.Max)
+	}
+	return nil
+}
+
+// Check reports an error describing the accepted range when limit is out of it.
+func (b LimitBounds) Check(limit int) error {
+	if limit < b.Min || limit > b.Max {
+		return fmt.Errorf("`%s` must be between %d and %d, got %d", LimitParam, b.Min, b.Max, limit)
+	}
+	return nil
+}
+
 type Pagination struct {
-	HasMore    bool   `json:"has_more,omitempty"`
-	NextCursor string `json:"next_cursor,omitempty"`
+	Limit       int    `json:"limit"`
+	HasMore     bool   `json:"has_more,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasPrevious bool   `json:"has_previous,omitempty"`
+	PrevCursor  string `json:"prev_cursor,omitempty"`
 }
 
 type HTTPSuccessResponse struct {
@@ -60,43 +139,52 @@ type HTTPSuccessResponse struct {
 	Message    string      `json:"message"`
 }
 
-// DecodeCursorToTime decodes a base64 URL-safe string back into a time.Time
-func DecodeCursorToTime(cursor string) (time.Time, error) {
-	decodedBytes, err := base64.RawURLEncoding.DecodeString(cursor)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid cursor encoding: `%s`, error: %v", cursor, err)
-	}
-
-	t, err := time.Parse(time.RFC3339Nano, string(decodedBytes))
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid cursor time format: `%s`, error: %v", cursor, err)
+// EncodeCursor returns the opaque form of cursor, or an empty string when
+// there is no such page.
+func EncodeCursor(cursor *shared.Cursor) string {
+	if cursor == nil {
+		return ""
 	}
-	return t, nil
-}
-
-// EncodeTimeToCursor encodes a time.Time into a base64 URL-safe string
-func EncodeTimeToCursor(t time.Time) string {
-	timeStr := t.UTC().Format(time.RFC3339Nano)
-	return base64.RawURLEncoding.EncodeToString([]byte(timeStr))
+	return cursor.Encode()
 }
 
-func ParseCursor(r *http.Request) (time.Time, error) {
-	cursorStr := r.URL.Query().Get(CursorParm)
-	if cursorStr == "" {
-		return time.Time{}, nil
+// NewPagination converts repository pagination into its response form,
+// echoing the effective limit of the page.
+func NewPagination(pagination models.Pagination, limit int) *Pagination {
+	return &Pagination{
+		Limit:       limit,
+		HasMore:     pagination.HasMore,
+		NextCursor:  EncodeCursor(pagination.NextCursor),
+		HasPrevious: pagination.HasPrevious,
+		PrevCursor:  EncodeCursor(pagination.PrevCursor),
 	}
+}
 
-	createdAfter, err := DecodeCursorToTime(cursorStr)
-	if err != nil {
-		return time.Time{}, err
+// ParseCursor reads the `cursor` param, which selects the page after it, or
+// the `before` param, which selects the page before it.
+func ParseCursor(r *http.Request) (*shared.Cursor, shared.PageDirection, error) {
+	query := r.URL.Query()
+	cursorStr, beforeStr := query.Get(CursorParm), query.Get(BeforeParm)
+	switch {
+	case cursorStr != "" && beforeStr != "":
+		return nil, "", fmt.Errorf("`%s` and `%s` params are mutually exclusive", CursorParm, BeforeParm)
+	case beforeStr != "":
+		cursor, err := shared.DecodeCursor(beforeStr)
+		return cursor, shared.PageBackward, err
+	case cursorStr != "":
+		cursor, err := shared.DecodeCursor(cursorStr)
+		return cursor, shared.PageForward, err
+	default:
+		return nil, shared.PageForward, nil
 	}
-	return createdAfter, nil
 }
 
-func ParseLimit(r *http.Request) (int, error) {
+// ParseLimit reads the `limit` param, falling back to defaultLimit when it
+// is absent.
+func ParseLimit(r *http.Request, defaultLimit int) (int, error) {
 	limitStr := r.URL.Query().Get(LimitParam)
 	if limitStr == "" {
-		return DefaultLimit, nil
+		return defaultLimit, nil
 	}
 
 	val, err := strconv.ParseInt(limitStr, 10, 32)
@@ -107,69 +195,316 @@ func ParseLimit(r *http.Request) (int, error) {
 	return int(val), nil
 }
 
+// ParseSort reads the `sort` param, a comma separated list of fields each
+// optionally prefixed with `-` for descending order, e.g. `-price,name`.
+// Fields are validated against the allowed whitelist.
+func ParseSort(r *http.Request, allowed []string) ([]shared.SortOrder, error) {
+	sortStr := r.URL.Query().Get(SortParam)
+	if sortStr == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(sortStr, ",")
+	sortOrders := make([]shared.SortOrder, 0, len(fields))
+	for _, field := range fields {
+		sortOrder := shared.SortOrder{Field: strings.TrimSpace(field), Direction: shared.SortAsc}
+		if name, ok := strings.CutPrefix(sortOrder.Field, "-"); ok {
+			sortOrder = shared.SortOrder{Field: name, Direction: shared.SortDesc}
+		}
+
+		if !slices.Contains(allowed, sortOrder.Field) {
+			return nil, fmt.Errorf(
+				"unsupported sort field `%s`, allowed fields: %s",
+				sortOrder.Field,
+				strings.Join(allowed, ", "),
+			)
+		}
+		if slices.ContainsFunc(sortOrders, func(o shared.SortOrder) bool { return o.Field == sortOrder.Field }) {
+			return nil, fmt.Errorf("duplicate sort field `%s`", sortOrder.Field)
+		}
+		sortOrders = append(sortOrders, sortOrder)
+	}
+	return sortOrders, nil
+}
+
+// ParseSearchQuery reads the `q` full-text search param.
+func ParseSearchQuery(r *http.Request) (string, error) {
+	query := strings.TrimSpace(r.URL.Query().Get(QueryParam))
+	if len(query) > shared.MaxSearchQueryLength {
+		return "", fmt.Errorf("`%s` must be at most %d characters long", QueryParam, shared.MaxSearchQueryLength)
+	}
+	return query, nil
+}
+
+// resolveCursorSort keeps the sort encoded in cursor active when no sort was
+// requested, and otherwise checks that cursor was produced for sortOrders.
+func resolveCursorSort(
+	cursor *shared.Cursor,
+	sortOrders []shared.SortOrder,
+	sortFields []string,
+) ([]shared.SortOrder, error) {
+	if sortOrders == nil {
+		return cursor.SortOrders, nil
+	}
+
+	keyset, err := shared.KeysetOrders(sortOrders, sortFields)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.Validate(keyset); err != nil {
+		return nil, err
+	}
+	return sortOrders, nil
+}
+
+// ParseAndValidatePagination builds the list options from the pagination,
+// `sort` and `q` params. Searches may also sort by relevance. Without a
+// `sort` param the sort encoded in the cursor, if any, stays active. When any
+// param is invalid it logs the error and reports false together with the
+// details to include in the error response.
 func ParseAndValidatePagination(
 	r *http.Request,
+	limits LimitBounds,
+	sortFields []string,
 	op string,
 	logger interfaces.AppLogger,
-) (time.Time, int, bool) {
-	cursor, err := ParseCursor(r)
+) (shared.ListOptions, any, bool) {
+	cursor, direction, err := ParseCursor(r)
 	if err != nil {
-		appLogger := logger.Logger()
+		appLogger := logger.FromContext(r.Context())
 		appLogger.Err(err).
 			Str("op", op).
 			Int("code", ErrCodeInvalidRequestParam).
 			Msg(ErrMessageInvalidRequestParam)
-		return time.Time{}, 0, false
+		return shared.ListOptions{}, err.Error(), false
 	}
 
-	limit, err := ParseLimit(r)
+	limit, err := ParseLimit(r, limits.Default)
 	if err != nil {
-		appLogger := logger.Logger()
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestParam).
+			Msg(ErrMessageInvalidRequestParam)
+		return shared.ListOptions{}, err.Error(), false
+	}
+
+	if err := limits.Check(limit); err != nil {
+		appLogger := logger.FromContext(r.Context())
 		appLogger.Err(err).
 			Str("op", op).
 			Int("code", ErrCodeInvalidRequestParam).
 			Msg(ErrMessageInvalidRequestParam)
-		return time.Time{}, 0, false
+		return shared.ListOptions{}, err.Error(), false
 	}
 
-	return cursor, limit, true
+	query, err := ParseSearchQuery(r)
+	if err != nil {
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestParam).
+			Msg(ErrMessageInvalidRequestParam)
+		return shared.ListOptions{}, err.Error(), false
+	}
+	if query != "" {
+		sortFields = append(slices.Clip(sortFields), shared.SortFieldRelevance)
+	}
+
+	sortOrders, err := ParseSort(r, sortFields)
+	if err == nil && cursor != nil {
+		sortOrders, err = resolveCursorSort(cursor, sortOrders, sortFields)
+	}
+	if err != nil {
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestParam).
+			Msg(ErrMessageInvalidRequestParam)
+		return shared.ListOptions{}, err.Error(), false
+	}
+
+	return shared.ListOptions{
+		Cursor:     cursor,
+		Direction:  direction,
+		Limit:      limit,
+		SortOrders: sortOrders,
+		Query:      query,
+	}, nil, true
+}
+
+func ParseID(r *http.Request) (uuid.UUID, error) {
+	idStr := r.PathValue(IDParam)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid id value: `%s`, error: %v", idStr, err)
+	}
+	return id, nil
+}
+
+func ParseAndValidateID(
+	r *http.Request,
+	op string,
+	logger interfaces.AppLogger,
+) (uuid.UUID, bool) {
+	id, err := ParseID(r)
+	if err != nil {
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestParam).
+			Msg(ErrMessageInvalidRequestParam)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// ParseRequestBody decodes the JSON request body into dst. Unknown fields,
+// trailing data and bodies larger than MaxRequestBodyBytes are rejected.
+func ParseRequestBody(
+	w http.ResponseWriter,
+	r *http.Request,
+	dst any,
+	op string,
+	logger interfaces.AppLogger,
+) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(dst)
+	if err == nil && decoder.More() {
+		err = errors.New("request body must contain a single JSON object")
+	}
+	if err != nil {
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestBody).
+			Msg(ErrMessageInvalidRequestBody)
+		return false
+	}
+	return true
+}
+
+// ValidateRequestBody validates req and logs any failure. The returned field
+// errors are meant for the `details` of the error response.
+func ValidateRequestBody(
+	ctx context.Context,
+	validate *Validator,
+	req any,
+	op string,
+	logger interfaces.AppLogger,
+) ([]FieldError, bool) {
+	fieldErrs, err := validate.Validate(req)
+	if err != nil {
+		appLogger := logger.FromContext(ctx)
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeValidationFailed).
+			Msg(ErrMessageValidationFailed)
+		return fieldErrs, false
+	}
+	return nil, true
+}
+
+// ErrorMapping describes the HTTP error response for a kind of domain error.
+type ErrorMapping struct {
+	StatusCode  int
+	Message     string
+	Code        int
+	CodeMessage string
+}
+
+// errorMappings holds the response for every shared.Kind. Kinds missing from
+// the table map to an internal server error.
+var errorMappings = map[shared.Kind]ErrorMapping{
+	shared.KindInternal: {
+		http.StatusInternalServerError, ErrMessageInternalServerError, ErrCodeInternal, ErrMessageInternal,
+	},
+	shared.KindValidation: {
+		http.StatusBadRequest, ErrMessageInvalidRequestParam, ErrCodeInvalidRequestParam, ErrMessageInvalidRequestParam,
+	},
+	shared.KindNotFound: {
+		http.StatusNotFound, ErrMessageNotFound, ErrCodeResourceNotFound, ErrMessageResourceNotFound,
+	},
+	shared.KindConflict: {
+		http.StatusConflict, ErrMessageConflict, ErrCodeResourceConflict, ErrMessageResourceConflict,
+	},
+	shared.KindUnavailable: {
+		http.StatusServiceUnavailable, ErrMessageUnavailable, ErrCodeServiceUnavailable, ErrMessageServiceUnavailable,
+	},
+	shared.KindTimeout: {
+		http.StatusGatewayTimeout, ErrMessageGatewayTimeout, ErrCodeTimeout, ErrMessageTimeout,
+	},
+}
+
+// MapError returns the HTTP error response for err based on its shared.Kind.
+// A deadline exceeded outside the repositories, such as the handler timeout,
+// is reported as a timeout as well.
+func MapError(err error) ErrorMapping {
+	kind := shared.KindOf(err)
+	if kind == shared.KindInternal && errors.Is(err, context.DeadlineExceeded) {
+		kind = shared.KindTimeout
+	}
+	if mapping, ok := errorMappings[kind]; ok {
+		return mapping
+	}
+	return errorMappings[shared.KindInternal]
+}
+
+// WriteRepositoryErrorResponse logs a repository error and writes the error
+// response selected by MapError.
+func WriteRepositoryErrorResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+	op string,
+	logger interfaces.AppLogger,
+) {
+	mapping := MapError(err)
+
+	appLogger := logger.FromContext(r.Context())
+	appLogger.Err(err).
+		Str("op", op).
+		Int("code", mapping.Code).
+		Msg(mapping.CodeMessage)
+
+	WriteErrorResponse(w, r, mapping.StatusCode, mapping.Message, nil, op, logger)
 }
 
 func writeResponse(
+	ctx context.Context,
 	w http.ResponseWriter,
 	statusCode int,
 	op string,
-	details any,
+	body any,
 	logger interfaces.AppLogger,
 ) {
 	var buf bytes.Buffer
-	if details != nil {
-		err := json.NewEncoder(&buf).Encode(details)
+	if body != nil {
+		err := json.NewEncoder(&buf).Encode(body)
 		if err != nil {
-			appLogger := logger.Logger()
+			appLogger := logger.FromContext(ctx)
 			appLogger.Err(err).
 				Str("op", op).
 				Int("code", ErrCodeJSONEncoding).
 				Msg(ErrMessageJSONEncoding)
-			WriteErrorResponse(
-				w,
-				http.StatusInternalServerError,
-				ErrMessageInternalServerError,
-				nil,
-				op,
-				logger,
-			)
+			writeResponse(ctx, w, http.StatusInternalServerError, op, internalErrorBody(body), logger)
 			return
 		}
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	contentType := ContentTypeJSON
+	if _, ok := body.(ProblemDetails); ok {
+		contentType = ContentTypeProblemJSON
+	}
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(statusCode)
 
 	// Write response body
 	if buf.Len() > 0 {
 		if _, err := buf.WriteTo(w); err != nil {
-			appLogger := logger.Logger()
+			appLogger := logger.FromContext(ctx)
 			appLogger.Err(err).
 				Str("op", op).
 				Int("code", ErrCodeFailedResponseWriter).
@@ -178,14 +513,40 @@ func writeResponse(
 	}
 }
 
+// internalErrorBody returns the internal server error body written in the
+// format of body when body itself cannot be encoded.
+func internalErrorBody(body any) any {
+	if problem, ok := body.(ProblemDetails); ok {
+		return ProblemDetails{
+			Type:      ProblemTypeDefault,
+			Title:     http.StatusText(http.StatusInternalServerError),
+			Status:    http.StatusInternalServerError,
+			Instance:  problem.Instance,
+			RequestID: problem.RequestID,
+		}
+	}
+	return HTTPErrorResponse{
+		Status: StatusError,
+		Error:  Error{Message: ErrMessageInternalServerError},
+	}
+}
+
+// WriteErrorResponse writes an error response for r, as RFC 7807 problem
+// details when wantsProblemDetails(r) and as HTTPErrorResponse otherwise.
 func WriteErrorResponse(
 	w http.ResponseWriter,
+	r *http.Request,
 	statusCode int,
 	message string,
 	details any,
 	op string,
 	logger interfaces.AppLogger,
 ) {
+	if wantsProblemDetails(r) {
+		writeResponse(r.Context(), w, statusCode, op, NewProblemDetails(r, statusCode, message, details), logger)
+		return
+	}
+
 	resp := HTTPErrorResponse{
 		Status: StatusError,
 		Error: Error{
@@ -194,10 +555,11 @@ func WriteErrorResponse(
 		},
 	}
 
-	writeResponse(w, statusCode, op, resp, logger)
+	writeResponse(r.Context(), w, statusCode, op, resp, logger)
 }
 
 func WriteSuccessResponse(
+	ctx context.Context,
 	w http.ResponseWriter,
 	statusCode int,
 	message string,
@@ -213,5 +575,5 @@ func WriteSuccessResponse(
 		Message:    message,
 	}
 
-	writeResponse(w, statusCode, op, resp, logger)
+	writeResponse(ctx, w, statusCode, op, resp, logger)
 }
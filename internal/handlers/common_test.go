@@ -3,14 +3,17 @@ package handlers
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"product-services/internal/logger"
 	"product-services/internal/mocks"
+	"product-services/internal/shared"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -26,8 +29,9 @@ func TestWriteResponse(t *testing.T) {
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
 
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
 		rw := httptest.NewRecorder()
-		writeResponse(rw, http.StatusOK, op, data, logger)
+		writeResponse(req.Context(), rw, http.StatusOK, op, data, logger)
 
 		expectedResponse := `{
 			"id": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
@@ -46,10 +50,12 @@ func TestWriteResponse(t *testing.T) {
 		data.Next = data
 
 		var logBuf bytes.Buffer
-		logger := logger.NewLogger(env, service, &logBuf)
+		appLogger := logger.NewLogger(env, service, &logBuf)
 
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req = req.WithContext(logger.WithRequestID(req.Context(), "req-123"))
 		rw := httptest.NewRecorder()
-		writeResponse(rw, http.StatusOK, op, data, logger)
+		writeResponse(req.Context(), rw, http.StatusOK, op, data, appLogger)
 
 		expectedResponse := `{
 			"status":"error",
@@ -68,6 +74,7 @@ func TestWriteResponse(t *testing.T) {
 			assert.Equal(t, "ProductService", entry["service"])
 			assert.Equal(t, op, entry["op"])
 			assert.Equal(t, float64(1001), entry["code"])
+			assert.Equal(t, "req-123", entry["request_id"])
 			assert.NotNil(t, entry["time"])
 			errMsg := "json: unsupported value: encountered a cycle via *handlers.Node"
 			assert.Equal(t, errMsg, entry["error"])
@@ -89,7 +96,8 @@ func TestWriteResponse(t *testing.T) {
 		var logBuf bytes.Buffer
 		logger := logger.NewLogger(env, service, &logBuf)
 
-		writeResponse(mockResponseWriter, http.StatusOK, op, data, logger)
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		writeResponse(req.Context(), mockResponseWriter, http.StatusOK, op, data, logger)
 		// verify log content
 		scanner := bufio.NewScanner(&logBuf)
 		for scanner.Scan() {
@@ -108,3 +116,36 @@ func TestWriteResponse(t *testing.T) {
 		}
 	})
 }
+
+func TestLimitBoundsValidate(t *testing.T) {
+	assert.NoError(t, DefaultLimitBounds.Validate())
+
+	for _, bounds := range []LimitBounds{
+		{Min: 0, Max: 10, Default: 5},
+		{Min: 1, Max: shared.MaxListLimit + 1, Default: 5},
+		{Min: 10, Max: 5, Default: 5},
+		{Min: 1, Max: 10, Default: 11},
+	} {
+		assert.Error(t, bounds.Validate(), "bounds %+v", bounds)
+	}
+}
+
+func TestMapError(t *testing.T) {
+	for _, tc := range []struct {
+		err        error
+		statusCode int
+		code       int
+	}{
+		{shared.Errorf(shared.KindValidation, "bad sort"), http.StatusBadRequest, ErrCodeInvalidRequestParam},
+		{fmt.Errorf("get: %w", shared.ErrNotFound), http.StatusNotFound, ErrCodeResourceNotFound},
+		{shared.Errorf(shared.KindConflict, "duplicate name"), http.StatusConflict, ErrCodeResourceConflict},
+		{shared.Errorf(shared.KindUnavailable, "connection refused"), http.StatusServiceUnavailable, ErrCodeServiceUnavailable},
+		{shared.ErrTimeout, http.StatusGatewayTimeout, ErrCodeTimeout},
+		{fmt.Errorf("list: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, ErrCodeTimeout},
+		{errors.New("boom"), http.StatusInternalServerError, ErrCodeInternal},
+	} {
+		mapping := MapError(tc.err)
+		assert.Equal(t, tc.statusCode, mapping.StatusCode, tc.err.Error())
+		assert.Equal(t, tc.code, mapping.Code, tc.err.Error())
+	}
+}
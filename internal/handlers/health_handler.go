@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"product-services/internal/interfaces"
+)
+
+// Health statuses reported by the health endpoints.
+const (
+	HealthStatusOK           = "ok"
+	HealthStatusUnavailable  = "unavailable"
+	HealthStatusShuttingDown = "shutting_down"
+)
+
+// Dependency is a backend the service needs to serve requests, such as the
+// repository database.
+type Dependency struct {
+	Name string
+	Ping func(ctx context.Context) error
+}
+
+// HealthStatus is the body of the health endpoints.
+type HealthStatus struct {
+	Status       string            `json:"status"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// HealthHandler serves the liveness and readiness probes.
+type HealthHandler struct {
+	dependencies []Dependency
+	logger       interfaces.AppLogger
+	ctxTimeOut   time.Duration
+	shuttingDown atomic.Bool
+}
+
+func NewHealthHandler(
+	dependencies []Dependency,
+	logger interfaces.AppLogger,
+	ctxTimeOut time.Duration,
+) *HealthHandler {
+	return &HealthHandler{
+		dependencies: dependencies,
+		logger:       logger,
+		ctxTimeOut:   ctxTimeOut,
+	}
+}
+
+// SetShuttingDown makes the readiness probe fail so that no new traffic is
+// routed to the service while it shuts down.
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Liveness reports that the process is up. It does not check the
+// dependencies so that their outage does not get the service restarted.
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	const op = "HealthHandler.Liveness"
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Service is alive",
+		HealthStatus{Status: HealthStatusOK},
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+// Readiness pings every dependency and reports whether the service can serve
+// requests, failing as soon as it is shutting down.
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	const op = "HealthHandler.Readiness"
+	if h.shuttingDown.Load() {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusServiceUnavailable,
+			ErrMessageUnavailable,
+			HealthStatus{Status: HealthStatusShuttingDown},
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	health, isReady := PingDependencies(ctx, h.dependencies, op, h.logger)
+	if !isReady {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusServiceUnavailable,
+			ErrMessageUnavailable,
+			health,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Service is ready",
+		health,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+// PingDependencies pings dependencies concurrently and reports whether all
+// of them are available, logging the failed pings.
+func PingDependencies(
+	ctx context.Context,
+	dependencies []Dependency,
+	op string,
+	logger interfaces.AppLogger,
+) (HealthStatus, bool) {
+	errs := make([]error, len(dependencies))
+	var wg sync.WaitGroup
+	for i, dependency := range dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = dependency.Ping(ctx)
+		}()
+	}
+	wg.Wait()
+
+	health := HealthStatus{
+		Status:       HealthStatusOK,
+		Dependencies: make(map[string]string, len(dependencies)),
+	}
+	for i, dependency := range dependencies {
+		if errs[i] == nil {
+			health.Dependencies[dependency.Name] = HealthStatusOK
+			continue
+		}
+		health.Status = HealthStatusUnavailable
+		health.Dependencies[dependency.Name] = HealthStatusUnavailable
+
+		code := ErrCodeServiceUnavailable
+		if errors.Is(errs[i], context.DeadlineExceeded) {
+			code = ErrCodeTimeout
+		}
+		appLogger := logger.FromContext(ctx)
+		appLogger.Err(fmt.Errorf("dependency `%s` is unavailable, error: %w", dependency.Name, errs[i])).
+			Str("op", op).
+			Int("code", code).
+			Msg(ErrMessageServiceUnavailable)
+	}
+	return health, health.Status == HealthStatusOK
+}
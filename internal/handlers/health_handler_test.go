@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiveness(t *testing.T) {
+	t.Run("should respond ok without pinging the dependencies", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewHealthHandler([]Dependency{{
+			Name: "postgres",
+			Ping: func(context.Context) error {
+				t.Fatal("liveness must not ping the dependencies")
+				return nil
+			},
+		}}, logger, time.Second)
+
+		rw := httptest.NewRecorder()
+		h.Liveness(rw, httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"status": "success",
+			"message": "Service is alive",
+			"data": {"status": "ok"}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+}
+
+func TestReadiness(t *testing.T) {
+	t.Run("should respond ok if every dependency is available", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewHealthHandler([]Dependency{
+			{Name: "postgres", Ping: func(context.Context) error { return nil }},
+			{Name: "memory", Ping: func(context.Context) error { return nil }},
+		}, logger, time.Second)
+
+		rw := httptest.NewRecorder()
+		h.Readiness(rw, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"status": "success",
+			"message": "Service is ready",
+			"data": {"status": "ok", "dependencies": {"postgres": "ok", "memory": "ok"}}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Empty(t, logBuf.String())
+	})
+
+	t.Run("should respond with service unavailable if a ping exceeds the timeout", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewHealthHandler([]Dependency{
+			{Name: "memory", Ping: func(context.Context) error { return nil }},
+			{Name: "postgres", Ping: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}},
+		}, logger, 10*time.Millisecond)
+
+		rw := httptest.NewRecorder()
+		h.Readiness(rw, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+		expectedResponse := `{
+			"status": "error",
+			"error": {
+				"message": "Service Unavailable",
+				"details": {"status": "unavailable", "dependencies": {"postgres": "unavailable", "memory": "ok"}}
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+		assert.Equal(t, float64(ErrCodeTimeout), entry["code"])
+		assert.Equal(t, "dependency `postgres` is unavailable, error: context deadline exceeded", entry["error"])
+		assert.Contains(t, entry["caller"], "internal/handlers/health_handler.go")
+	})
+
+	t.Run("should respond with service unavailable once shutting down", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewHealthHandler([]Dependency{
+			{Name: "postgres", Ping: func(context.Context) error { return nil }},
+		}, logger, time.Second)
+		h.SetShuttingDown()
+
+		rw := httptest.NewRecorder()
+		h.Readiness(rw, httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+		expectedResponse := `{
+			"status": "error",
+			"error": {"message": "Service Unavailable", "details": {"status": "shutting_down"}}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+}
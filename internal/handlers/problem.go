@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"product-services/internal/logger"
+)
+
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeProblemJSON = "application/problem+json"
+
+	// ProblemTypeDefault is the RFC 7807 type of problems that carry no
+	// semantics beyond their HTTP status code.
+	ProblemTypeDefault = "about:blank"
+)
+
+// ErrorFormat selects the body of error responses.
+type ErrorFormat string
+
+const (
+	// ErrorFormatJSON writes the HTTPErrorResponse envelope unless the client
+	// asks for problem details through the Accept header.
+	ErrorFormatJSON ErrorFormat = "json"
+	// ErrorFormatProblem writes RFC 7807 problem details to every client.
+	ErrorFormatProblem ErrorFormat = "problem"
+)
+
+// ParseErrorFormat validates an ErrorFormat read from configuration.
+func ParseErrorFormat(s string) (ErrorFormat, error) {
+	switch format := ErrorFormat(s); format {
+	case ErrorFormatJSON, ErrorFormatProblem:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported error format: `%s`, must be %s or %s", s, ErrorFormatJSON, ErrorFormatProblem)
+	}
+}
+
+// ProblemDetails is an RFC 7807 error response. Field level details that do
+// not fit in Detail are reported as the `errors` extension member.
+type ProblemDetails struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Errors    any    `json:"errors,omitempty"`
+}
+
+// NewProblemDetails builds the problem details of an error response for r.
+// String details become the problem detail, anything else is reported
+// under `errors` with message as the detail.
+func NewProblemDetails(r *http.Request, statusCode int, message string, details any) ProblemDetails {
+	problem := ProblemDetails{
+		Type:      ProblemTypeDefault,
+		Title:     http.StatusText(statusCode),
+		Status:    statusCode,
+		Detail:    message,
+		Instance:  r.URL.Path,
+		RequestID: logger.RequestIDFromContext(r.Context()),
+	}
+	if s, ok := details.(string); ok {
+		problem.Detail = s
+	} else {
+		problem.Errors = details
+	}
+	return problem
+}
+
+type errorFormatKey struct{}
+
+// WithErrorFormat makes format the error format of every request served by
+// next.
+func WithErrorFormat(next http.Handler, format ErrorFormat) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), errorFormatKey{}, format)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// wantsProblemDetails reports whether the error response for r should be
+// written as problem details, either because the server is configured to do
+// so or because the client accepts application/problem+json.
+func wantsProblemDetails(r *http.Request) bool {
+	if format, ok := r.Context().Value(errorFormatKey{}).(ErrorFormat); ok && format == ErrorFormatProblem {
+		return true
+	}
+
+	for _, accept := range r.Header.Values("Accept") {
+		for mediaRange := range strings.SplitSeq(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(mediaRange)
+			if err == nil && mediaType == ContentTypeProblemJSON && params["q"] != "0" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"product-services/internal/logger"
+	"product-services/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteErrorResponseProblemDetails(t *testing.T) {
+	const op = "TestHandler.TestMethod"
+
+	t.Run("should write problem details if the client accepts them", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?limit=500", http.NoBody)
+		req = req.WithContext(logger.WithRequestID(req.Context(), "req-123"))
+		req.Header.Set("Accept", "application/json, application/problem+json;q=0.9")
+		rw := httptest.NewRecorder()
+
+		WriteErrorResponse(rw, req, http.StatusBadRequest, ErrMessageInvalidRequestParam, "`limit` is too large", op, appLogger)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		assert.Equal(t, ContentTypeProblemJSON, rw.Header().Get("Content-Type"))
+		expectedResponse := `{
+			"type": "about:blank",
+			"title": "Bad Request",
+			"status": 400,
+			"detail": "` + "`limit` is too large" + `",
+			"instance": "/products",
+			"request_id": "req-123"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+
+	t.Run("should report structured details as errors", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+
+		req := httptest.NewRequest(http.MethodPost, "/categories", http.NoBody)
+		req.Header.Set("Accept", ContentTypeProblemJSON)
+		rw := httptest.NewRecorder()
+
+		details := []map[string]string{{"field": "name", "rule": "required"}}
+		WriteErrorResponse(rw, req, http.StatusBadRequest, ErrMessageValidationFailed, details, op, logger)
+
+		expectedResponse := `{
+			"type": "about:blank",
+			"title": "Bad Request",
+			"status": 400,
+			"detail": "Request validation failed",
+			"instance": "/categories",
+			"errors": [{"field": "name", "rule": "required"}]
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+
+	t.Run("should write problem details if configured", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+
+		handler := WithErrorFormat(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			WriteRepositoryErrorResponse(w, r, shared.ErrNotFound, op, logger)
+		}), ErrorFormatProblem)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/1", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		handler.ServeHTTP(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		assert.Equal(t, ContentTypeProblemJSON, rw.Header().Get("Content-Type"))
+		expectedResponse := `{
+			"type": "about:blank",
+			"title": "Not Found",
+			"status": 404,
+			"detail": "Not Found",
+			"instance": "/categories/1"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+	})
+
+	t.Run("should keep the default envelope otherwise", func(t *testing.T) {
+		for _, accept := range []string{"", "application/json", "application/problem+json;q=0"} {
+			var logBuf bytes.Buffer
+			logger := logger.NewLogger(env, service, &logBuf)
+
+			handler := WithErrorFormat(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				WriteErrorResponse(w, r, http.StatusNotFound, ErrMessageNotFound, nil, op, logger)
+			}), ErrorFormatJSON)
+
+			req := httptest.NewRequest(http.MethodGet, "/categories/1", http.NoBody)
+			req.Header.Set("Accept", accept)
+			rw := httptest.NewRecorder()
+
+			handler.ServeHTTP(rw, req)
+
+			assert.Equal(t, ContentTypeJSON, rw.Header().Get("Content-Type"), accept)
+			assert.JSONEq(t, `{"status":"error","error":{"message":"Not Found"}}`, rw.Body.String(), accept)
+		}
+	})
+}
+
+func TestParseErrorFormat(t *testing.T) {
+	format, err := ParseErrorFormat("problem")
+	assert.NoError(t, err)
+	assert.Equal(t, ErrorFormatProblem, format)
+
+	_, err = ParseErrorFormat("xml")
+	assert.EqualError(t, err, "unsupported error format: `xml`, must be json or problem")
+}
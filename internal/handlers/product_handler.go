@@ -0,0 +1,431 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+type ProductHandler struct {
+	repo         interfaces.ProductRepository
+	categoryRepo interfaces.CategoryRepository
+	util         interfaces.SystemUtil
+	logger       interfaces.AppLogger
+	validate     *Validator
+	ctxTimeOut   time.Duration
+	limits       LimitBounds
+}
+
+func NewProductHandler(
+	repo interfaces.ProductRepository,
+	categoryRepo interfaces.CategoryRepository,
+	util interfaces.SystemUtil,
+	logger interfaces.AppLogger,
+	validate *Validator,
+	ctxTimeOut time.Duration,
+	limits LimitBounds,
+) *ProductHandler {
+	return &ProductHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		util:         util,
+		logger:       logger,
+		validate:     validate,
+		ctxTimeOut:   ctxTimeOut,
+		limits:       limits,
+	}
+}
+
+func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
+	const op = "ProductHandler.ListProducts"
+	listOptions, details, isValid := ParseAndValidatePagination(r, h.limits, models.ProductSortFields, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			details,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	filter, details, isValid := ParseAndValidateProductFilter(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			details,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	result, err := h.repo.ListProducts(ctx, shared.ProductListOptions{
+		ListOptions: listOptions,
+		Filter:      filter,
+	})
+	if err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully fetched list of products",
+		result.Products,
+		NewPagination(result.Pagination, listOptions.Limit),
+		op,
+		h.logger,
+	)
+}
+
+func (h *ProductHandler) GetProduct(w http.ResponseWriter, r *http.Request) {
+	const op = "ProductHandler.GetProduct"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	product, err := h.repo.GetProductByID(ctx, id)
+	if err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully fetched product",
+		product,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	const op = "ProductHandler.CreateProduct"
+	var req models.ProductRequest
+	if !ParseRequestBody(w, r, &req, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	fieldErrs, isValid := ValidateRequestBody(r.Context(), h.validate, &req, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageValidationFailed,
+			fieldErrs,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if !h.ensureCategoryExists(ctx, w, r, req.CategoryID, op) {
+		return
+	}
+
+	now := h.util.CurrentTime()
+	product := &models.Product{
+		ID:          h.util.NewUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+		ImageURL:    req.ImageURL,
+		CategoryID:  req.CategoryID,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+		TimeStamps: models.TimeStamps{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	if err := h.repo.CreateProduct(ctx, product); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusCreated,
+		"Successfully created product",
+		product,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
+	const op = "ProductHandler.UpdateProduct"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	var req models.ProductRequest
+	if !ParseRequestBody(w, r, &req, op, h.logger) {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestBody,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	fieldErrs, isValid := ValidateRequestBody(r.Context(), h.validate, &req, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageValidationFailed,
+			fieldErrs,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if !h.ensureCategoryExists(ctx, w, r, req.CategoryID, op) {
+		return
+	}
+
+	product := &models.Product{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		ImageURL:    req.ImageURL,
+		CategoryID:  req.CategoryID,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+		TimeStamps: models.TimeStamps{
+			UpdatedAt: h.util.CurrentTime(),
+		},
+	}
+
+	if err := h.repo.UpdateProduct(ctx, product); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully updated product",
+		product,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+func (h *ProductHandler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
+	const op = "ProductHandler.DeleteProduct"
+	id, isValid := ParseAndValidateID(r, op, h.logger)
+	if !isValid {
+		WriteErrorResponse(
+			w,
+			r,
+			http.StatusBadRequest,
+			ErrMessageInvalidRequestParam,
+			nil,
+			op,
+			h.logger,
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.ctxTimeOut)
+	defer cancel()
+
+	if err := h.repo.DeleteProduct(ctx, id); err != nil {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return
+	}
+
+	WriteSuccessResponse(
+		r.Context(),
+		w,
+		http.StatusOK,
+		"Successfully deleted product",
+		nil,
+		nil,
+		op,
+		h.logger,
+	)
+}
+
+// ensureCategoryExists verifies that categoryID references an existing
+// category. On failure it writes the error response and returns false.
+func (h *ProductHandler) ensureCategoryExists(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	categoryID uuid.UUID,
+	op string,
+) bool {
+	_, err := h.categoryRepo.GetCategoryByID(ctx, categoryID)
+	if err == nil {
+		return true
+	}
+
+	if !errors.Is(err, shared.ErrNotFound) {
+		WriteRepositoryErrorResponse(w, r, err, op, h.logger)
+		return false
+	}
+
+	appLogger := h.logger.FromContext(ctx)
+	appLogger.Err(fmt.Errorf("category `%s` does not exist", categoryID)).
+		Str("op", op).
+		Int("code", ErrCodeInvalidCategoryRef).
+		Msg(ErrMessageInvalidCategoryRef)
+	WriteErrorResponse(
+		w,
+		r,
+		http.StatusBadRequest,
+		ErrMessageInvalidCategoryRef,
+		nil,
+		op,
+		h.logger,
+	)
+	return false
+}
+
+// ParseProductFilter reads the `category_id`, `min_price`, `max_price` and
+// `in_stock` params. Absent params are left unset.
+func ParseProductFilter(r *http.Request) (shared.ProductFilter, error) {
+	query := r.URL.Query()
+	var filter shared.ProductFilter
+
+	if value := query.Get(CategoryIDParam); value != "" {
+		categoryID, err := uuid.Parse(value)
+		if err != nil {
+			return shared.ProductFilter{}, fmt.Errorf("invalid %s value: `%s`, error: %v", CategoryIDParam, value, err)
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	var err error
+	if filter.MinPrice, err = parsePriceParam(query, MinPriceParam); err != nil {
+		return shared.ProductFilter{}, err
+	}
+	if filter.MaxPrice, err = parsePriceParam(query, MaxPriceParam); err != nil {
+		return shared.ProductFilter{}, err
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return shared.ProductFilter{}, fmt.Errorf("`%s` must not exceed `%s`", MinPriceParam, MaxPriceParam)
+	}
+
+	if value := query.Get(InStockParam); value != "" {
+		inStock, err := strconv.ParseBool(value)
+		if err != nil {
+			return shared.ProductFilter{}, fmt.Errorf("invalid %s value: `%s`, must be true or false", InStockParam, value)
+		}
+		filter.InStock = &inStock
+	}
+
+	return filter, nil
+}
+
+// parsePriceParam reads the price param, which must be a non-negative number
+// when present.
+func parsePriceParam(query url.Values, param string) (*float64, error) {
+	value := query.Get(param)
+	if value == "" {
+		return nil, nil
+	}
+	price, err := strconv.ParseFloat(value, 64)
+	if err != nil || price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return nil, fmt.Errorf("invalid %s value: `%s`, must be a non-negative number", param, value)
+	}
+	return &price, nil
+}
+
+// ParseAndValidateProductFilter parses the product filter params. When any of
+// them is invalid it logs the error and reports false together with the
+// details to include in the error response.
+func ParseAndValidateProductFilter(
+	r *http.Request,
+	op string,
+	logger interfaces.AppLogger,
+) (shared.ProductFilter, any, bool) {
+	filter, err := ParseProductFilter(r)
+	if err != nil {
+		appLogger := logger.FromContext(r.Context())
+		appLogger.Err(err).
+			Str("op", op).
+			Int("code", ErrCodeInvalidRequestParam).
+			Msg(ErrMessageInvalidRequestParam)
+		return shared.ProductFilter{}, err.Error(), false
+	}
+	return filter, nil, true
+}
@@ -0,0 +1,990 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+	"product-services/internal/mocks"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testProductOne = models.Product{
+		ID:          uuid.MustParse("3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"),
+		Name:        "Test Product A",
+		Description: "Test product a description",
+		ImageURL:    "https://example.com/a.png",
+		CategoryID:  testCategoryOne.ID,
+		Price:       9.99,
+		Quantity:    10,
+		TimeStamps: models.TimeStamps{
+			CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	testProductTwo = models.Product{
+		ID:          uuid.MustParse("9a1d7c2b-0e4f-4b6a-8c3d-2f5e7a9b1c04"),
+		Name:        "Test Product B",
+		Description: "Test product b description",
+		CategoryID:  testCategoryTwo.ID,
+		Price:       19.5,
+		Quantity:    3,
+		TimeStamps: models.TimeStamps{
+			CreatedAt: time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC),
+		},
+	}
+)
+
+func TestListProducts(t *testing.T) {
+	t.Run("should respond with bad request if limit is invalid", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?limit=ss", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Invalid request param",
+				"details": "invalid limit value: ` + "`ss`" + `, error: strconv.ParseInt: parsing \"ss\": invalid syntax"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "ProductHandler.ListProducts", entry["op"])
+			assert.Equal(t, float64(1000), entry["code"])
+			assert.Contains(t, entry["caller"], "internal/handlers/product_handler.go")
+		}
+	})
+
+	t.Run("should apply the configured limit bounds", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		limits := LimitBounds{Min: 2, Max: 5, Default: 3}
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, limits)
+
+		listOptions := shared.ListOptions{Direction: shared.PageForward, Limit: 3}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&models.ListProductsResult{Products: []*models.Product{&testProductOne}}, nil)
+
+		rw := httptest.NewRecorder()
+		h.ListProducts(rw, httptest.NewRequest(http.MethodGet, "/products", http.NoBody))
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Contains(t, rw.Body.String(), `"pagination":{"limit":3}`)
+
+		rw = httptest.NewRecorder()
+		h.ListProducts(rw, httptest.NewRequest(http.MethodGet, "/products?limit=6", http.NoBody))
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		assert.Contains(t, rw.Body.String(), "`limit` must be between 2 and 5, got 6")
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should pass the requested sort to the repository", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		sortOrders := []shared.SortOrder{
+			{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+			{Field: shared.SortFieldName, Direction: shared.SortAsc},
+		}
+		listOptions := shared.ListOptions{Direction: shared.PageForward, Limit: DefaultLimit, SortOrders: sortOrders}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&models.ListProductsResult{Products: []*models.Product{&testProductTwo, &testProductOne}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?sort=-price,name", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request listing the allowed sort fields", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?sort=-price,description", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Invalid request param",
+				"details": "unsupported sort field ` + "`description`" + `, allowed fields: name, price, quantity, created_at, updated_at"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if the cursor was produced for another sort", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		orders := []shared.SortOrder{{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc}}
+		reqURL := "/products?sort=name&cursor=" + shared.NewCursor(orders, &testProductOne).Encode()
+		req := httptest.NewRequest(http.MethodGet, reqURL, http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		assert.Contains(t, rw.Body.String(), "cursor does not match the requested sort order")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should pass the requested filters to the repository", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		minPrice, maxPrice, inStock := 5.0, 20.5, true
+		listOptions := shared.ProductListOptions{
+			ListOptions: shared.ListOptions{Direction: shared.PageForward, Limit: DefaultLimit},
+			Filter: shared.ProductFilter{
+				CategoryID: &testProductOne.CategoryID,
+				MinPrice:   &minPrice,
+				MaxPrice:   &maxPrice,
+				InStock:    &inStock,
+			},
+		}
+		mockRepo.On("ListProducts", mock.Anything, listOptions).
+			Return(&models.ListProductsResult{Products: []*models.Product{&testProductOne}}, nil)
+
+		reqURL := "/products?category_id=" + testProductOne.CategoryID.String() +
+			"&min_price=5&max_price=20.5&in_stock=true"
+		req := httptest.NewRequest(http.MethodGet, reqURL, http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should pass the search query to the repository", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		listOptions := shared.ListOptions{
+			Direction:  shared.PageForward,
+			Limit:      DefaultLimit,
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldRelevance, Direction: shared.SortDesc}},
+			Query:      "red chair",
+		}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&models.ListProductsResult{Products: []*models.Product{&testProductOne}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?q=+red+chair+&sort=-relevance", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if the search query is invalid", func(t *testing.T) {
+		for query, details := range map[string]string{
+			"q=" + strings.Repeat("a", shared.MaxSearchQueryLength+1): "`q` must be at most 200 characters long",
+			"sort=relevance": "unsupported sort field `relevance`, allowed fields: name, price, quantity, created_at, updated_at",
+		} {
+			mockRepo := new(mocks.MockProductRepository)
+			mockCategoryRepo := new(mocks.MockCategoryRepository)
+			mockUtil := new(mocks.MockSystemUtil)
+
+			var logBuf bytes.Buffer
+			logger := logger.NewLogger(env, service, &logBuf)
+			h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+			req := httptest.NewRequest(http.MethodGet, "/products?"+query, http.NoBody)
+			rw := httptest.NewRecorder()
+
+			h.ListProducts(rw, req)
+
+			assert.Equal(t, http.StatusBadRequest, rw.Code, query)
+			var response HTTPErrorResponse
+			require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &response))
+			assert.Equal(t, details, response.Error.Details, query)
+			mockRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("should respond with bad request if a filter is invalid", func(t *testing.T) {
+		for query, details := range map[string]string{
+			"category_id=abc":          "invalid category_id value: `abc`, error: invalid UUID length: 3",
+			"min_price=-1":             "invalid min_price value: `-1`, must be a non-negative number",
+			"max_price=NaN":            "invalid max_price value: `NaN`, must be a non-negative number",
+			"min_price=10&max_price=5": "`min_price` must not exceed `max_price`",
+			"max_price=x&min_price=y":  "invalid min_price value: `y`, must be a non-negative number",
+			"in_stock=maybe":           "invalid in_stock value: `maybe`, must be true or false",
+		} {
+			mockRepo := new(mocks.MockProductRepository)
+			mockCategoryRepo := new(mocks.MockCategoryRepository)
+			mockUtil := new(mocks.MockSystemUtil)
+
+			var logBuf bytes.Buffer
+			logger := logger.NewLogger(env, service, &logBuf)
+			h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+			req := httptest.NewRequest(http.MethodGet, "/products?"+query, http.NoBody)
+			rw := httptest.NewRecorder()
+
+			h.ListProducts(rw, req)
+
+			assert.Equal(t, http.StatusBadRequest, rw.Code, query)
+			var response HTTPErrorResponse
+			require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &response))
+			assert.Equal(t, details, response.Error.Details, query)
+			mockRepo.AssertExpectations(t)
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+			assert.Equal(t, float64(1000), entry["code"])
+			assert.Contains(t, entry["caller"], "internal/handlers/product_handler.go")
+		}
+	})
+
+	t.Run("should respond with internal server error if repo fails", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		listOptions := shared.ListOptions{Direction: shared.PageForward, Limit: DefaultLimit}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&models.ListProductsResult{}, errors.New("db query error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/products", http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Internal Server Error"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "ProductHandler.ListProducts", entry["op"])
+			assert.Equal(t, float64(1600), entry["code"])
+			assert.Equal(t, "db query error", entry["error"])
+			assert.Contains(t, entry["caller"], "internal/handlers/product_handler.go")
+		}
+	})
+
+	t.Run("should respond with list of products if params are valid", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		orders := []shared.SortOrder{{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc}}
+		cursor := shared.NewCursor(orders, &testProductOne)
+		nextCursor := shared.NewCursor(orders, &testProductTwo)
+		listProductsResult := models.ListProductsResult{
+			Products: []*models.Product{&testProductOne, &testProductTwo},
+			Pagination: models.Pagination{
+				NextCursor: nextCursor,
+				HasMore:    true,
+			},
+		}
+		listOptions := shared.ListOptions{
+			Cursor:     cursor,
+			Direction:  shared.PageForward,
+			Limit:      2,
+			SortOrders: cursor.SortOrders,
+		}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&listProductsResult, nil)
+
+		reqURL := "/products?cursor=" + cursor.Encode() + "&limit=2"
+		req := httptest.NewRequest(http.MethodGet, reqURL, http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := fmt.Sprintf(`{
+			"data": [
+				{
+				"id": "3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01",
+				"name": "Test Product A",
+				"description": "Test product a description",
+				"imageUrl": "https://example.com/a.png",
+				"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"price": 9.99,
+				"quantity": 10
+				},
+				{
+				"id": "9a1d7c2b-0e4f-4b6a-8c3d-2f5e7a9b1c04",
+				"name": "Test Product B",
+				"description": "Test product b description",
+				"imageUrl": "",
+				"categoryID": "b12f2176-28ca-4acf-85b9-cc97ca1b3cf6",
+				"price": 19.5,
+				"quantity": 3
+				}
+			],
+			"message": "Successfully fetched list of products",
+			"pagination": {
+				"limit": 2,
+				"has_more": true,
+				"next_cursor": %q
+			},
+			"status": "success"
+		}`, nextCursor.Encode())
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should list the page before the before cursor", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		orders := []shared.SortOrder{{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc}}
+		before := shared.NewCursor(orders, &testProductTwo)
+		prevCursor := shared.NewCursor(orders, &testProductOne)
+		listProductsResult := models.ListProductsResult{
+			Products: []*models.Product{&testProductOne},
+			Pagination: models.Pagination{
+				NextCursor:  prevCursor,
+				PrevCursor:  prevCursor,
+				HasMore:     true,
+				HasPrevious: true,
+			},
+		}
+		listOptions := shared.ListOptions{
+			Cursor:     before,
+			Direction:  shared.PageBackward,
+			Limit:      1,
+			SortOrders: before.SortOrders,
+		}
+		mockRepo.On("ListProducts", mock.Anything, shared.ProductListOptions{ListOptions: listOptions}).
+			Return(&listProductsResult, nil)
+
+		reqURL := "/products?before=" + before.Encode() + "&limit=1"
+		req := httptest.NewRequest(http.MethodGet, reqURL, http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		var response struct {
+			Pagination Pagination `json:"pagination"`
+		}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &response))
+		assert.Equal(t, Pagination{
+			Limit:       1,
+			HasMore:     true,
+			NextCursor:  prevCursor.Encode(),
+			HasPrevious: true,
+			PrevCursor:  prevCursor.Encode(),
+		}, response.Pagination)
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if both cursor and before are set", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		reqURL := "/products?cursor=MjAyMy0wMS0wMVQwMDowMDowMFo&before=MjAyMy0wMS0wMVQwMDowMDowMFo"
+		req := httptest.NewRequest(http.MethodGet, reqURL, http.NoBody)
+		rw := httptest.NewRecorder()
+
+		h.ListProducts(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Invalid request param",
+				"details": "` + "`cursor` and `before` params are mutually exclusive" + `"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		mockRepo.AssertExpectations(t)
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+		assert.Equal(t, "`cursor` and `before` params are mutually exclusive", entry["error"])
+		assert.Equal(t, float64(1000), entry["code"])
+	})
+}
+
+func TestGetProduct(t *testing.T) {
+	t.Run("should respond with not found if product does not exist", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("GetProductByID", mock.Anything, testProductOne.ID).
+			Return((*models.Product)(nil), shared.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.GetProduct(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Not Found"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should respond with product if it exists", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("GetProductByID", mock.Anything, testProductOne.ID).
+			Return(&testProductOne, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.GetProduct(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"id": "3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01",
+				"name": "Test Product A",
+				"description": "Test product a description",
+				"imageUrl": "https://example.com/a.png",
+				"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"price": 9.99,
+				"quantity": 10
+			},
+			"message": "Successfully fetched product",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestCreateProduct(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	body := `{
+		"name": "Test Product A",
+		"description": "Test product a description",
+		"imageUrl": "https://example.com/a.png",
+		"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+		"price": 9.99,
+		"quantity": 10
+	}`
+
+	t.Run("should respond with bad request if validation fails", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(`{"name": "Test Product A"}`))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Request validation failed",
+				"details": [
+					{"field": "categoryID", "rule": "required", "message": "categoryID is a required field"}
+				]
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if price or quantity is negative", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		negativeBody := `{
+			"name": "Test Product A",
+			"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+			"price": -0.5,
+			"quantity": -1
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(negativeBody))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Request validation failed",
+				"details": [
+					{"field": "price", "rule": "gte", "param": "0", "message": "price must be 0 or greater"},
+					{"field": "quantity", "rule": "gte", "param": "0", "message": "quantity must be 0 or greater"}
+				]
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should create product with zero price and quantity", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		expectedProduct := &models.Product{
+			ID:         testProductOne.ID,
+			Name:       "Free Sample",
+			CategoryID: testCategoryOne.ID,
+			TimeStamps: models.TimeStamps{CreatedAt: now, UpdatedAt: now},
+		}
+		mockCategoryRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return(&testCategoryOne, nil)
+		mockUtil.On("CurrentTime").Return(now)
+		mockUtil.On("NewUUID").Return(testProductOne.ID)
+		mockRepo.On("CreateProduct", mock.Anything, expectedProduct).Return(nil)
+
+		zeroBody := `{
+			"name": "Free Sample",
+			"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+			"price": 0,
+			"quantity": 0
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(zeroBody))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if category does not exist", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockCategoryRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return((*models.Category)(nil), shared.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Referenced category does not exist"
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+
+		// verify log content
+		scanner := bufio.NewScanner(&logBuf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			assert.NoError(t, err)
+			assert.Equal(t, "ProductHandler.CreateProduct", entry["op"])
+			assert.Equal(t, float64(1005), entry["code"])
+			errMsg := "category `f2aa335f-6f91-4d4d-8057-53b0009bc376` does not exist"
+			assert.Equal(t, errMsg, entry["error"])
+			assert.Contains(t, entry["caller"], "internal/handlers/product_handler.go")
+		}
+	})
+
+	t.Run("should respond with internal server error if category lookup fails", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockCategoryRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return((*models.Category)(nil), errors.New("db query error"))
+
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should create product if request is valid", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		expectedProduct := testProductOne
+		expectedProduct.TimeStamps = models.TimeStamps{CreatedAt: now, UpdatedAt: now}
+
+		mockCategoryRepo.On("GetCategoryByID", mock.Anything, testCategoryOne.ID).
+			Return(&testCategoryOne, nil)
+		mockUtil.On("CurrentTime").Return(now)
+		mockUtil.On("NewUUID").Return(testProductOne.ID)
+		mockRepo.On("CreateProduct", mock.Anything, &expectedProduct).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+
+		h.CreateProduct(rw, req)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"id": "3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01",
+				"name": "Test Product A",
+				"description": "Test product a description",
+				"imageUrl": "https://example.com/a.png",
+				"categoryID": "f2aa335f-6f91-4d4d-8057-53b0009bc376",
+				"price": 9.99,
+				"quantity": 10
+			},
+			"message": "Successfully created product",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestUpdateProduct(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	body := `{
+		"name": "Renamed Product",
+		"categoryID": "b12f2176-28ca-4acf-85b9-cc97ca1b3cf6",
+		"price": 12.5,
+		"quantity": 4
+	}`
+
+	t.Run("should respond with bad request if id is invalid", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		req := httptest.NewRequest(http.MethodPut, "/products/123", strings.NewReader(body))
+		req.SetPathValue(IDParam, "123")
+		rw := httptest.NewRecorder()
+
+		h.UpdateProduct(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should respond with bad request if quantity is negative", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		negativeBody := `{
+			"name": "Renamed Product",
+			"categoryID": "b12f2176-28ca-4acf-85b9-cc97ca1b3cf6",
+			"price": 12.5,
+			"quantity": -4
+		}`
+		req := httptest.NewRequest(http.MethodPut, "/products/"+testProductOne.ID.String(), strings.NewReader(negativeBody))
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.UpdateProduct(rw, req)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		expectedResponse := `{
+			"status":"error",
+			"error": {
+				"message": "Request validation failed",
+				"details": [
+					{"field": "quantity", "rule": "gte", "param": "0", "message": "quantity must be 0 or greater"}
+				]
+			}
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should update product if request is valid", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		expectedProduct := &models.Product{
+			ID:         testProductOne.ID,
+			Name:       "Renamed Product",
+			CategoryID: testCategoryTwo.ID,
+			Price:      12.5,
+			Quantity:   4,
+			TimeStamps: models.TimeStamps{UpdatedAt: now},
+		}
+		mockCategoryRepo.On("GetCategoryByID", mock.Anything, testCategoryTwo.ID).
+			Return(&testCategoryTwo, nil)
+		mockUtil.On("CurrentTime").Return(now)
+		mockRepo.On("UpdateProduct", mock.Anything, expectedProduct).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/products/"+testProductOne.ID.String(), strings.NewReader(body))
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.UpdateProduct(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"data": {
+				"id": "3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01",
+				"name": "Renamed Product",
+				"description": "",
+				"imageUrl": "",
+				"categoryID": "b12f2176-28ca-4acf-85b9-cc97ca1b3cf6",
+				"price": 12.5,
+				"quantity": 4
+			},
+			"message": "Successfully updated product",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+		assert.Equal(t, "", logBuf.String())
+
+		mockRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestDeleteProduct(t *testing.T) {
+	t.Run("should respond with not found if product does not exist", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("DeleteProduct", mock.Anything, testProductOne.ID).Return(shared.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.DeleteProduct(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should delete product if it exists", func(t *testing.T) {
+		mockRepo := new(mocks.MockProductRepository)
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		h := NewProductHandler(mockRepo, mockCategoryRepo, mockUtil, logger, NewValidator(), ctxTimeOut, DefaultLimitBounds)
+
+		mockRepo.On("DeleteProduct", mock.Anything, testProductOne.ID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductOne.ID.String(), http.NoBody)
+		req.SetPathValue(IDParam, testProductOne.ID.String())
+		rw := httptest.NewRecorder()
+
+		h.DeleteProduct(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		expectedResponse := `{
+			"message": "Successfully deleted product",
+			"status": "success"
+		}`
+		assert.JSONEq(t, expectedResponse, rw.Body.String())
+
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+)
+
+// FieldError describes a request body field that failed validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// Validator validates request bodies and reports failures as FieldErrors
+// named after the JSON fields, with messages from its translator.
+type Validator struct {
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+// RegisterTranslationsFunc registers the messages of a locale, such as the
+// RegisterDefaultTranslations functions of validator/v10/translations.
+type RegisterTranslationsFunc func(v *validator.Validate, trans ut.Translator) error
+
+// NewValidator returns a Validator with English messages.
+func NewValidator() *Validator {
+	english := en.New()
+	translator, _ := ut.New(english, english).GetTranslator(english.Locale())
+
+	v, err := NewTranslatedValidator(translator, entranslations.RegisterDefaultTranslations)
+	if err != nil {
+		// The default English translations are static and always register.
+		panic(err)
+	}
+	return v
+}
+
+// NewTranslatedValidator returns a Validator whose messages are registered
+// on translator by register.
+func NewTranslatedValidator(translator ut.Translator, register RegisterTranslationsFunc) (*Validator, error) {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(jsonFieldName)
+	if err := register(validate, translator); err != nil {
+		return nil, fmt.Errorf("failed to register `%s` validation messages, error: %w", translator.Locale(), err)
+	}
+	return &Validator{validate: validate, translator: translator}, nil
+}
+
+// Validate checks req against its `validate` tags. Field failures are
+// returned as FieldErrors alongside the validation error.
+func (v *Validator) Validate(req any) ([]FieldError, error) {
+	err := v.validate.Struct(req)
+	if err == nil {
+		return nil, nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil, err
+	}
+
+	fieldErrs := make([]FieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		fieldErrs = append(fieldErrs, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: fe.Translate(v.translator),
+		})
+	}
+	return fieldErrs, err
+}
+
+// fieldPath returns the dotted JSON path of fe without the root struct name.
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.IndexByte(namespace, '.'); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+// jsonFieldName names struct fields after their JSON keys in validation
+// errors.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"testing"
+
+	"product-services/internal/models"
+
+	"github.com/go-playground/locales/fr"
+	ut "github.com/go-playground/universal-translator"
+	frtranslations "github.com/go-playground/validator/v10/translations/fr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	t.Run("should report failed fields by their JSON name", func(t *testing.T) {
+		fieldErrs, err := NewValidator().Validate(&models.ProductRequest{
+			Name:     "Test Product A",
+			ImageURL: string(make([]byte, 256)),
+			Price:    9.99,
+			Quantity: 1,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, []FieldError{
+			{Field: "imageUrl", Rule: "max", Param: "255", Message: "imageUrl must be a maximum of 255 characters in length"},
+			{Field: "categoryID", Rule: "required", Message: "categoryID is a required field"},
+		}, fieldErrs)
+	})
+
+	t.Run("should accept valid requests", func(t *testing.T) {
+		fieldErrs, err := NewValidator().Validate(&models.CategoryRequest{Name: "Books"})
+
+		assert.NoError(t, err)
+		assert.Nil(t, fieldErrs)
+	})
+
+	t.Run("should translate messages", func(t *testing.T) {
+		french := fr.New()
+		translator, _ := ut.New(french, french).GetTranslator(french.Locale())
+		v, err := NewTranslatedValidator(translator, frtranslations.RegisterDefaultTranslations)
+		require.NoError(t, err)
+
+		fieldErrs, err := v.Validate(&models.CategoryRequest{})
+
+		require.Error(t, err)
+		assert.Equal(t, []FieldError{
+			{Field: "name", Rule: "required", Message: "name est un champ obligatoire"},
+		}, fieldErrs)
+	})
+}
@@ -29,7 +29,7 @@ type (
 		GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error)
 		ListProducts(
 			ctx context.Context,
-			listOptions shared.ListOptions,
+			listOptions shared.ProductListOptions,
 		) (*models.ListProductsResult, error)
 		CreateProduct(ctx context.Context, product *models.Product) error
 		UpdateProduct(ctx context.Context, product *models.Product) error
@@ -40,6 +40,16 @@ type (
 	AppLogger interface {
 		// Logger returns the underlying zerolog.Logger instance.
 		Logger() zerolog.Logger
+		// FromContext returns the logger enriched with the request scoped
+		// fields stored in ctx: request ID, route, user, trace and span IDs.
+		FromContext(ctx context.Context) zerolog.Logger
+		// WithContext returns a copy of ctx whose loggers additionally carry
+		// the field key.
+		WithContext(ctx context.Context, key, value string) context.Context
+		// Level returns the minimum level of the logged events.
+		Level() zerolog.Level
+		// SetLevel changes the minimum level of the logged events at runtime.
+		SetLevel(level zerolog.Level)
 		Fatal(err error, msg string)
 	}
 
@@ -47,6 +57,11 @@ type (
 	// Useful for testability and mocking.
 	SystemUtil interface {
 		CurrentTime() time.Time
+		// Now returns the current time with its monotonic clock reading, to
+		// measure elapsed time with Since.
+		Now() time.Time
+		// Since returns the time elapsed since start, a time returned by Now.
+		Since(start time.Time) time.Duration
 		NewUUID() uuid.UUID
 	}
 )
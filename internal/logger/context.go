@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rs/zerolog"
+)
+
+// Names of the request scoped fields added to log lines.
+const (
+	FieldRequestID = "request_id"
+	FieldRoute     = "route"
+	FieldUserID    = "user_id"
+	FieldTraceID   = "trace_id"
+	FieldSpanID    = "span_id"
+)
+
+type field struct {
+	key   string
+	value string
+}
+
+type fieldsKey struct{}
+
+// WithField returns a copy of ctx whose logs additionally carry key. A key
+// that is already set is overwritten.
+func WithField(ctx context.Context, key, value string) context.Context {
+	fields := contextFields(ctx)
+	fields = slices.DeleteFunc(slices.Clone(fields), func(f field) bool { return f.key == key })
+	return context.WithValue(ctx, fieldsKey{}, append(fields, field{key: key, value: value}))
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return WithField(ctx, FieldRequestID, requestID)
+}
+
+// WithUserID returns a copy of ctx carrying the ID of the authenticated user,
+// for the authentication code to call once it has identified the caller.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return WithField(ctx, FieldUserID, userID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or an empty
+// string when there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	return fieldFromContext(ctx, FieldRequestID)
+}
+
+// RouteFromContext returns the route pattern carried by ctx, or an empty
+// string when there is none.
+func RouteFromContext(ctx context.Context) string {
+	return fieldFromContext(ctx, FieldRoute)
+}
+
+func fieldFromContext(ctx context.Context, key string) string {
+	for _, f := range contextFields(ctx) {
+		if f.key == key {
+			return f.value
+		}
+	}
+	return ""
+}
+
+func contextFields(ctx context.Context) []field {
+	fields, _ := ctx.Value(fieldsKey{}).([]field)
+	return fields
+}
+
+// withFields returns logger with the fields carried by ctx.
+func withFields(logger zerolog.Logger, ctx context.Context) zerolog.Logger {
+	fields := contextFields(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+
+	logCtx := logger.With()
+	for _, f := range fields {
+		logCtx = logCtx.Str(f.key, f.value)
+	}
+	return logCtx.Logger()
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	var logBuf bytes.Buffer
+	appLogger := NewLogger("prod", "ProductService", &logBuf)
+
+	ctx := WithRequestID(context.Background(), "req-123")
+	ctx = appLogger.WithContext(ctx, FieldRoute, "GET /products")
+	ctx = appLogger.WithContext(ctx, FieldTraceID, "trace-1")
+	ctx = appLogger.WithContext(ctx, FieldTraceID, "trace-2")
+
+	zlog := appLogger.FromContext(ctx)
+	zlog.Info().Msg("listed products")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+	assert.Equal(t, "req-123", entry[FieldRequestID])
+	assert.Equal(t, "GET /products", entry[FieldRoute])
+	assert.Equal(t, "trace-2", entry[FieldTraceID])
+	assert.NotContains(t, entry, FieldUserID)
+	assert.Equal(t, "req-123", RequestIDFromContext(ctx))
+}
+
+func TestWithUserID(t *testing.T) {
+	var logBuf bytes.Buffer
+	appLogger := NewLogger("prod", "ProductService", &logBuf)
+
+	ctx := WithUserID(WithRequestID(context.Background(), "req-123"), "user-42")
+
+	zlog := appLogger.FromContext(ctx)
+	zlog.Info().Msg("updated product")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logBuf.Bytes(), &entry))
+	assert.Equal(t, "req-123", entry[FieldRequestID])
+	assert.Equal(t, "user-42", entry[FieldUserID])
+}
+
+func TestLoggerLevels(t *testing.T) {
+	var prodBuf, devBuf bytes.Buffer
+	prodLogger := NewLogger("production", "ProductService", &prodBuf)
+	devLogger := NewLogger("development", "ProductService", &devBuf)
+
+	prodLog, devLog := prodLogger.Logger(), devLogger.Logger()
+	prodLog.Debug().Msg("hidden")
+	devLog.Debug().Msg("visible")
+
+	assert.Empty(t, prodBuf.String())
+	assert.Contains(t, devBuf.String(), "visible")
+
+	prodLogger.SetLevel(zerolog.DebugLevel)
+	zlog := prodLogger.FromContext(context.Background())
+	zlog.Debug().Msg("visible after SetLevel")
+
+	assert.Contains(t, prodBuf.String(), "visible after SetLevel")
+	assert.Equal(t, zerolog.DebugLevel, devLogger.Level())
+	assert.Equal(t, zerolog.GlobalLevel(), zerolog.TraceLevel)
+}
@@ -1,8 +1,10 @@
 package logger
 
 import (
+	"context"
 	"io"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"product-services/internal/interfaces"
@@ -12,8 +14,13 @@ import (
 
 type DefaultLogger struct {
 	logger zerolog.Logger
+	level  *atomic.Int32
 }
 
+// NewLogger returns a logger writing JSON lines to w. Production
+// environments log at info level and everything else at debug level; use
+// SetLevel to override it. The level only applies to this logger and the
+// loggers derived from it.
 func NewLogger(appEnv string, svc string, w io.Writer) interfaces.AppLogger {
 	zerolog.TimeFieldFormat = time.RFC3339
 	logger := zerolog.New(w).With().
@@ -22,19 +29,46 @@ func NewLogger(appEnv string, svc string, w io.Writer) interfaces.AppLogger {
 		CallerWithSkipFrameCount(3).
 		Logger()
 
-	// Set log level based on environment
+	l := &DefaultLogger{logger: logger, level: new(atomic.Int32)}
+	l.SetLevel(DefaultLevel(appEnv))
+	return l
+}
+
+// DefaultLevel returns the log level of appEnv: info in production and
+// debug otherwise.
+func DefaultLevel(appEnv string) zerolog.Level {
 	appEnv = strings.ToLower(appEnv)
 	if appEnv == "production" || appEnv == "prod" {
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	} else {
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+		return zerolog.InfoLevel
 	}
-
-	return &DefaultLogger{logger: logger}
+	return zerolog.DebugLevel
 }
 
+// Logger returns the underlying logger at the current level.
 func (l *DefaultLogger) Logger() zerolog.Logger {
-	return l.logger
+	return l.logger.Level(l.Level())
+}
+
+// FromContext returns the logger with the request scoped fields, such as the
+// request ID, route, user, trace and span IDs, stored in ctx.
+func (l *DefaultLogger) FromContext(ctx context.Context) zerolog.Logger {
+	return withFields(l.Logger(), ctx)
+}
+
+// WithContext returns a copy of ctx whose loggers additionally carry key.
+func (l *DefaultLogger) WithContext(ctx context.Context, key, value string) context.Context {
+	return WithField(ctx, key, value)
+}
+
+// Level returns the minimum level of the logged events.
+func (l *DefaultLogger) Level() zerolog.Level {
+	return zerolog.Level(l.level.Load())
+}
+
+// SetLevel changes the minimum level of the logged events. It is safe to
+// call while the logger is in use.
+func (l *DefaultLogger) SetLevel(level zerolog.Level) {
+	l.level.Store(int32(level))
 }
 
 func (l *DefaultLogger) Fatal(err error, msg string) {
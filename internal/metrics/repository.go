@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// kindLabels are the values of the `kind` label of repository errors.
+var kindLabels = map[shared.Kind]string{
+	shared.KindInternal:    "internal",
+	shared.KindNotFound:    "not_found",
+	shared.KindConflict:    "conflict",
+	shared.KindValidation:  "validation",
+	shared.KindUnavailable: "unavailable",
+	shared.KindTimeout:     "timeout",
+}
+
+// observeRepositoryCall records the duration of a repository call and, when
+// err is not nil, its error kind.
+func (m *Metrics) observeRepositoryCall(repository, method string, duration time.Duration, err error) {
+	m.repoCallDuration.WithLabelValues(repository, method).Observe(duration.Seconds())
+	if err != nil {
+		m.repoErrors.WithLabelValues(repository, method, kindLabels[shared.KindOf(err)]).Inc()
+	}
+}
+
+type categoryRepository struct {
+	next    interfaces.CategoryRepository
+	metrics *Metrics
+	util    interfaces.SystemUtil
+}
+
+// InstrumentCategoryRepository returns repo recording the duration, measured
+// with util, and errors of each of its calls in m.
+func InstrumentCategoryRepository(
+	repo interfaces.CategoryRepository,
+	m *Metrics,
+	util interfaces.SystemUtil,
+) interfaces.CategoryRepository {
+	return &categoryRepository{next: repo, metrics: m, util: util}
+}
+
+func (r *categoryRepository) GetCategoryByID(ctx context.Context, id uuid.UUID) (_ *models.Category, err error) {
+	defer r.observe("GetCategoryByID", r.util.Now(), &err)
+	return r.next.GetCategoryByID(ctx, id)
+}
+
+func (r *categoryRepository) ListCategories(
+	ctx context.Context,
+	listOptions shared.ListOptions,
+) (_ *models.ListCategoriesResult, err error) {
+	defer r.observe("ListCategories", r.util.Now(), &err)
+	return r.next.ListCategories(ctx, listOptions)
+}
+
+func (r *categoryRepository) CreateCategory(ctx context.Context, category *models.Category) (err error) {
+	defer r.observe("CreateCategory", r.util.Now(), &err)
+	return r.next.CreateCategory(ctx, category)
+}
+
+func (r *categoryRepository) UpdateCategory(ctx context.Context, category *models.Category) (err error) {
+	defer r.observe("UpdateCategory", r.util.Now(), &err)
+	return r.next.UpdateCategory(ctx, category)
+}
+
+func (r *categoryRepository) DeleteCategory(ctx context.Context, id uuid.UUID) (err error) {
+	defer r.observe("DeleteCategory", r.util.Now(), &err)
+	return r.next.DeleteCategory(ctx, id)
+}
+
+func (r *categoryRepository) observe(method string, start time.Time, err *error) {
+	r.metrics.observeRepositoryCall("category", method, r.util.Since(start), *err)
+}
+
+type productRepository struct {
+	next    interfaces.ProductRepository
+	metrics *Metrics
+	util    interfaces.SystemUtil
+}
+
+// InstrumentProductRepository returns repo recording the duration, measured
+// with util, and errors of each of its calls in m.
+func InstrumentProductRepository(
+	repo interfaces.ProductRepository,
+	m *Metrics,
+	util interfaces.SystemUtil,
+) interfaces.ProductRepository {
+	return &productRepository{next: repo, metrics: m, util: util}
+}
+
+func (r *productRepository) GetProductByID(ctx context.Context, id uuid.UUID) (_ *models.Product, err error) {
+	defer r.observe("GetProductByID", r.util.Now(), &err)
+	return r.next.GetProductByID(ctx, id)
+}
+
+func (r *productRepository) ListProducts(
+	ctx context.Context,
+	listOptions shared.ProductListOptions,
+) (_ *models.ListProductsResult, err error) {
+	defer r.observe("ListProducts", r.util.Now(), &err)
+	return r.next.ListProducts(ctx, listOptions)
+}
+
+func (r *productRepository) CreateProduct(ctx context.Context, product *models.Product) (err error) {
+	defer r.observe("CreateProduct", r.util.Now(), &err)
+	return r.next.CreateProduct(ctx, product)
+}
+
+func (r *productRepository) UpdateProduct(ctx context.Context, product *models.Product) (err error) {
+	defer r.observe("UpdateProduct", r.util.Now(), &err)
+	return r.next.UpdateProduct(ctx, product)
+}
+
+func (r *productRepository) DeleteProduct(ctx context.Context, id uuid.UUID) (err error) {
+	defer r.observe("DeleteProduct", r.util.Now(), &err)
+	return r.next.DeleteProduct(ctx, id)
+}
+
+func (r *productRepository) observe(method string, start time.Time, err *error) {
+	r.metrics.observeRepositoryCall("product", method, r.util.Since(start), *err)
+}
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"product-services/internal/mocks"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestInstrumentCategoryRepository(t *testing.T) {
+	t.Run("should record the calls and the kind of their errors", func(t *testing.T) {
+		m := New()
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+		mockUtil.On("Since", start).Return(10 * time.Millisecond)
+		category := &models.Category{ID: uuid.New()}
+		missingID := uuid.New()
+		mockRepo.On("GetCategoryByID", mock.Anything, category.ID).Return(category, nil)
+		mockRepo.On("GetCategoryByID", mock.Anything, missingID).
+			Return((*models.Category)(nil), shared.Errorf(shared.KindNotFound, "category `%s` does not exist", missingID))
+		repo := InstrumentCategoryRepository(mockRepo, m, mockUtil)
+
+		got, err := repo.GetCategoryByID(context.Background(), category.ID)
+		require.NoError(t, err)
+		assert.Equal(t, category, got)
+		_, err = repo.GetCategoryByID(context.Background(), missingID)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+
+		expected := `
+# HELP product_service_repository_errors_total Number of failed repository calls by repository, method and error kind.
+# TYPE product_service_repository_errors_total counter
+product_service_repository_errors_total{kind="not_found",method="GetCategoryByID",repository="category"} 1
+`
+		require.NoError(t, testutil.GatherAndCompare(
+			m.Registry(),
+			strings.NewReader(expected),
+			"product_service_repository_errors_total",
+		))
+		count, _ := repositoryHistogram(t, m, "category", "GetCategoryByID")
+		assert.Equal(t, uint64(2), count)
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+func TestInstrumentProductRepository(t *testing.T) {
+	t.Run("should record internal errors of unknown kind", func(t *testing.T) {
+		m := New()
+		mockRepo := new(mocks.MockProductRepository)
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+		mockUtil.On("Since", start).Return(250 * time.Millisecond)
+		mockRepo.On("DeleteProduct", mock.Anything, mock.Anything).Return(assert.AnError)
+		repo := InstrumentProductRepository(mockRepo, m, mockUtil)
+
+		err := repo.DeleteProduct(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, assert.AnError)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.repoErrors.WithLabelValues("product", "DeleteProduct", "internal")))
+		count, sum := repositoryHistogram(t, m, "product", "DeleteProduct")
+		assert.Equal(t, uint64(1), count)
+		assert.Equal(t, 0.25, sum)
+		mockRepo.AssertExpectations(t)
+		mockUtil.AssertExpectations(t)
+	})
+}
+
+// repositoryHistogram returns the sample count and sum of the call duration
+// histogram of a repository method.
+func repositoryHistogram(t *testing.T, m *Metrics, repository, method string) (uint64, float64) {
+	t.Helper()
+
+	families, err := m.Registry().Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "product_service_repository_call_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["repository"] == repository && labels["method"] == method {
+				histogram := metric.GetHistogram()
+				return histogram.GetSampleCount(), histogram.GetSampleSum()
+			}
+		}
+	}
+	return 0, 0
+}
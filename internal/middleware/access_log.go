@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"product-services/internal/interfaces"
+
+	"github.com/rs/zerolog"
+)
+
+// AccessLogConfig configures the AccessLog middleware.
+type AccessLogConfig struct {
+	// SampleSuccessEvery logs one of every N successful (below 400)
+	// requests: 1 logs all of them and 0 none. Client and server errors
+	// are always logged.
+	SampleSuccessEvery uint32
+}
+
+// DefaultAccessLogConfig logs every request.
+var DefaultAccessLogConfig = AccessLogConfig{SampleSuccessEvery: 1}
+
+// AccessLog logs the method, status, bytes written, latency and client IP
+// of every request through appLogger, along with the route template and
+// request ID stored in the request context.
+// Server errors are logged at error level, client errors at warn level and
+// successful requests, subject to sampling, at info level.
+func AccessLog(
+	appLogger interfaces.AppLogger,
+	util interfaces.SystemUtil,
+	cfg AccessLogConfig,
+) Middleware {
+	var successes atomic.Uint32
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := util.Now()
+			rec := newResponseRecorder(w)
+
+			next.ServeHTTP(rec, r)
+
+			status := rec.Status()
+			if status < http.StatusBadRequest && !sampled(&successes, cfg.SampleSuccessEvery) {
+				return
+			}
+			logAccess(appLogger, r, rec, util.Since(start))
+		})
+	}
+}
+
+// sampled reports whether the current request is one of every n counted by
+// counter.
+func sampled(counter *atomic.Uint32, n uint32) bool {
+	if n == 0 {
+		return false
+	}
+	return (counter.Add(1)-1)%n == 0
+}
+
+func logAccess(appLogger interfaces.AppLogger, r *http.Request, rec *responseRecorder, latency time.Duration) {
+	status := rec.Status()
+	level := zerolog.InfoLevel
+	switch {
+	case status >= http.StatusInternalServerError:
+		level = zerolog.ErrorLevel
+	case status >= http.StatusBadRequest:
+		level = zerolog.WarnLevel
+	}
+
+	zlog := appLogger.FromContext(r.Context())
+	zlog.WithLevel(level).
+		Str("method", r.Method).
+		Str("path", r.URL.Path).
+		Int("status", status).
+		Int("bytes", rec.bytesWritten).
+		Dur("latency", latency).
+		Str("client_ip", clientIP(r)).
+		Msg("HTTP request")
+}
+
+// clientIP returns the host of the remote address of r.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
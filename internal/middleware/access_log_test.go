@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+	"product-services/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	env     = "prod"
+	service = "ProductService"
+)
+
+func readEntries(t *testing.T, logBuf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(logBuf)
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAccessLog(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should log the request with its route and request ID", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+		mockUtil.On("Since", start).Return(42 * time.Millisecond)
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /products/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":"error"}`))
+		})
+		handler := Chain(mux, Route(mux, appLogger), AccessLog(appLogger, mockUtil, DefaultAccessLogConfig))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/42", http.NoBody)
+		req = req.WithContext(logger.WithRequestID(req.Context(), "req-123"))
+		req.RemoteAddr = "203.0.113.7:51234"
+		rw := httptest.NewRecorder()
+
+		handler.ServeHTTP(rw, req)
+
+		entries := readEntries(t, &logBuf)
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		assert.Equal(t, "warn", entry["level"])
+		assert.Equal(t, "HTTP request", entry["message"])
+		assert.Equal(t, "GET", entry["method"])
+		assert.Equal(t, "GET /products/{id}", entry["route"])
+		assert.Equal(t, "/products/42", entry["path"])
+		assert.Equal(t, float64(http.StatusNotFound), entry["status"])
+		assert.Equal(t, float64(len(`{"status":"error"}`)), entry["bytes"])
+		assert.Equal(t, float64(42), entry["latency"])
+		assert.Equal(t, "203.0.113.7", entry["client_ip"])
+		assert.Equal(t, "req-123", entry["request_id"])
+		mockUtil.AssertExpectations(t)
+	})
+
+	t.Run("should sample successful requests but log every error", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+		mockUtil.On("Since", start).Return(time.Duration(0))
+
+		statuses := []int{200, 200, 500, 200, 204, 400, 201}
+		var i int
+		handler := AccessLog(appLogger, mockUtil, AccessLogConfig{SampleSuccessEvery: 2})(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statuses[i])
+			}),
+		)
+
+		for i = range statuses {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+		}
+
+		var logged []float64
+		for _, entry := range readEntries(t, &logBuf) {
+			logged = append(logged, entry["status"].(float64))
+		}
+		assert.Equal(t, []float64{200, 500, 200, 400, 201}, logged)
+	})
+
+	t.Run("should not log successful requests if sampling is disabled", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+
+		handler := AccessLog(appLogger, mockUtil, AccessLogConfig{})(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			}),
+		)
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+		assert.Empty(t, logBuf.String())
+	})
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	tag := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	handler := Chain(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		calls = append(calls, "handler")
+	}), tag("outer"), tag("inner"))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	assert.Equal(t, []string{"outer", "inner", "handler"}, calls)
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/logger"
+	"product-services/internal/metrics"
+)
+
+// Metrics records the count and latency of every request in m, labelled by
+// method, status code and the route template stored by Route. Requests
+// matching no route are recorded as metrics.UnmatchedRoute.
+func Metrics(m *metrics.Metrics, util interfaces.SystemUtil) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := util.Now()
+			rec := newResponseRecorder(w)
+
+			next.ServeHTTP(rec, r)
+
+			m.ObserveHTTPRequest(r.Method, routePath(r), rec.Status(), util.Since(start))
+		})
+	}
+}
+
+// routePath returns the route template of r without its method, such as
+// `/products/{id}`.
+func routePath(r *http.Request) string {
+	route := logger.RouteFromContext(r.Context())
+	if _, path, found := strings.Cut(route, " "); found {
+		return path
+	}
+	return route
+}
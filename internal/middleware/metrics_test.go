@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+	"product-services/internal/metrics"
+	"product-services/internal/mocks"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should record requests by method, route template and status", func(t *testing.T) {
+		m := metrics.New()
+		mockUtil := new(mocks.MockSystemUtil)
+		mockUtil.On("Now").Return(start)
+		mockUtil.On("Since", start).Return(10 * time.Millisecond)
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /products/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if r.PathValue("id") == "missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		})
+		appLogger := logger.NewLogger(env, service, io.Discard)
+		handler := Chain(mux, Route(mux, appLogger), Metrics(m, mockUtil))
+
+		for _, path := range []string{"/products/1", "/products/2", "/products/missing", "/unknown"} {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, http.NoBody))
+		}
+
+		expected := `
+# HELP product_service_http_requests_total Number of HTTP requests by method, route and status code.
+# TYPE product_service_http_requests_total counter
+product_service_http_requests_total{method="GET",route="/products/{id}",status="200"} 2
+product_service_http_requests_total{method="GET",route="/products/{id}",status="404"} 1
+product_service_http_requests_total{method="GET",route="unmatched",status="404"} 1
+`
+		require.NoError(t, testutil.GatherAndCompare(
+			m.Registry(),
+			strings.NewReader(expected),
+			"product_service_http_requests_total",
+		))
+		assert.Equal(t, 3, testutil.CollectAndCount(m.Registry(), "product_service_http_request_duration_seconds"))
+	})
+}
@@ -0,0 +1,36 @@
+// Package middleware provides the HTTP middlewares wrapped around the API
+// router.
+package middleware
+
+import (
+	"net/http"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/logger"
+)
+
+// Middleware wraps an http.Handler with additional behavior.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps handler with middlewares, the first being the outermost.
+func Chain(handler http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// Route stores the mux pattern matching each request, such as
+// `GET /products/{id}`, in the request context with appLogger so that the
+// logs and the middlewares after it can report the route template instead of
+// the path. Requests matching no pattern carry no route.
+func Route(mux *http.ServeMux, appLogger interfaces.AppLogger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, pattern := mux.Handler(r); pattern != "" {
+				r = r.WithContext(appLogger.WithContext(r.Context(), logger.FieldRoute, pattern))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"product-services/internal/handlers"
+	"product-services/internal/interfaces"
+)
+
+// Recover turns a panic in the next handlers into a logged error and, if
+// nothing was written yet, a 500 response in the standard error format. The
+// http.ErrAbortHandler sentinel is re-panicked so that net/http aborts the
+// response as intended.
+func Recover(appLogger interfaces.AppLogger) Middleware {
+	const op = "Middleware.Recover"
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := newResponseRecorder(w)
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+
+				logPanic(appLogger, r, p, debug.Stack(), op)
+				if rec.status == 0 {
+					handlers.WriteErrorResponse(
+						rec,
+						r,
+						http.StatusInternalServerError,
+						handlers.ErrMessageInternalServerError,
+						nil,
+						op,
+						appLogger,
+					)
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+		})
+	}
+}
+
+func logPanic(appLogger interfaces.AppLogger, r *http.Request, p any, stack []byte, op string) {
+	err := fmt.Errorf("panic: %v", p)
+	if pErr, ok := p.(error); ok {
+		err = fmt.Errorf("panic: %w", pErr)
+	}
+
+	zlog := appLogger.FromContext(r.Context())
+	zlog.Err(err).
+		Str("op", op).
+		Int("code", handlers.ErrCodePanicRecovered).
+		Str("stack", string(stack)).
+		Msg(handlers.ErrMessagePanicRecovered)
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"product-services/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecover(t *testing.T) {
+	t.Run("should respond with internal server error and log the panic", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		handler := Recover(appLogger)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic("boom")
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/products", http.NoBody)
+		req = req.WithContext(logger.WithRequestID(req.Context(), "req-123"))
+		rw := httptest.NewRecorder()
+
+		handler.ServeHTTP(rw, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		assert.JSONEq(t, `{"status":"error","error":{"message":"Internal Server Error"}}`, rw.Body.String())
+
+		entries := readEntries(t, &logBuf)
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		assert.Equal(t, "error", entry["level"])
+		assert.Equal(t, "panic: boom", entry["error"])
+		assert.Equal(t, "Middleware.Recover", entry["op"])
+		assert.Equal(t, float64(1603), entry["code"])
+		assert.Equal(t, "req-123", entry["request_id"])
+		assert.Contains(t, entry["stack"], "recover_test.go")
+		assert.Equal(t, "Recovered from panic", entry["message"])
+	})
+
+	t.Run("should keep the response if it was already started", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		handler := Recover(appLogger)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			panic("late")
+		}))
+
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+		assert.Empty(t, rw.Body.String())
+		assert.Len(t, readEntries(t, &logBuf), 1)
+	})
+
+	t.Run("should re-panic to abort the response", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		handler := Recover(appLogger)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+		})
+		assert.Empty(t, logBuf.String())
+	})
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/logger"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	// MaxRequestIDLength bounds client supplied request IDs.
+	MaxRequestIDLength = 128
+)
+
+// RequestID accepts the X-Request-ID header of incoming requests, or
+// generates a new ID with util when it is missing or invalid. The ID is
+// stored in the request context with appLogger, so that the logs of the
+// request carry it, and echoed in the response header.
+func RequestID(appLogger interfaces.AppLogger, util interfaces.SystemUtil) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = util.NewUUID().String()
+			}
+
+			w.Header().Set(RequestIDHeader, requestID)
+			ctx := appLogger.WithContext(r.Context(), logger.FieldRequestID, requestID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// validRequestID reports whether id is short and made of printable ASCII
+// characters only, so it is safe to echo and log.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > MaxRequestIDLength {
+		return false
+	}
+	for i := range len(id) {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"product-services/internal/logger"
+	"product-services/internal/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	generated := uuid.MustParse("6f1c2d9b-8e01-4d5e-9a43-3c6f0f4e5b7a")
+
+	for _, tc := range []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"should accept the client request ID", "client-id-1", "client-id-1"},
+		{"should generate a request ID if none is sent", "", generated.String()},
+		{"should replace request IDs with invalid characters", "bad id\n", generated.String()},
+		{"should replace request IDs that are too long", strings.Repeat("a", MaxRequestIDLength+1), generated.String()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockUtil := new(mocks.MockSystemUtil)
+			if tc.expected == generated.String() {
+				mockUtil.On("NewUUID").Return(generated)
+			}
+
+			var requestID string
+			appLogger := logger.NewLogger(env, service, io.Discard)
+			handler := RequestID(appLogger, mockUtil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestID = logger.RequestIDFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/products", http.NoBody)
+			req.Header.Set(RequestIDHeader, tc.header)
+			rw := httptest.NewRecorder()
+
+			handler.ServeHTTP(rw, req)
+
+			assert.Equal(t, tc.expected, requestID)
+			assert.Equal(t, tc.expected, rw.Header().Get(RequestIDHeader))
+			mockUtil.AssertExpectations(t)
+		})
+	}
+}
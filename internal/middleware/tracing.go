@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/logger"
+	"product-services/internal/tracing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts a server span per request, continuing the trace of the W3C
+// `traceparent` header extracted by propagator, and stores its trace and
+// span IDs in the request context with appLogger so that they are added to
+// the logs. The span is named after the route template stored by Route, or
+// the method alone for requests matching no route. Server errors mark the
+// span as failed.
+func Tracing(
+	tp trace.TracerProvider,
+	propagator propagation.TextMapPropagator,
+	appLogger interfaces.AppLogger,
+) Middleware {
+	tracer := tp.Tracer(tracing.TracerName)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			route := routePath(r)
+			name := r.Method
+			if route != "" {
+				name += " " + route
+			}
+			ctx, span := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.URLPath(r.URL.Path),
+				),
+			)
+			defer span.End()
+			if route != "" {
+				span.SetAttributes(semconv.HTTPRoute(route))
+			}
+
+			if spanCtx := span.SpanContext(); spanCtx.IsValid() {
+				ctx = appLogger.WithContext(ctx, logger.FieldTraceID, spanCtx.TraceID().String())
+				ctx = appLogger.WithContext(ctx, logger.FieldSpanID, spanCtx.SpanID().String())
+			}
+
+			rec := newResponseRecorder(w)
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			status := rec.Status()
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+		})
+	}
+}
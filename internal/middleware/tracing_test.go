@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"product-services/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	const (
+		traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentID    = "00f067aa0ba902b7"
+		traceparent = "00-" + traceID + "-" + parentID + "-01"
+	)
+
+	t.Run("should continue the incoming trace and add its IDs to the logs", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		appLogger := logger.NewLogger(env, service, &logBuf)
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /products/{id}", func(w http.ResponseWriter, r *http.Request) {
+			zlog := appLogger.FromContext(r.Context())
+			zlog.Info().Msg("handled")
+			w.WriteHeader(http.StatusNotFound)
+		})
+		handler := Chain(mux, Route(mux, appLogger), Tracing(tp, propagation.TraceContext{}, appLogger))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/42", http.NoBody)
+		req.Header.Set("traceparent", traceparent)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "GET /products/{id}", span.Name())
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+		assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+		assert.Equal(t, parentID, span.Parent().SpanID().String())
+		assert.Contains(t, span.Attributes(), attribute.String("http.route", "/products/{id}"))
+		assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+		assert.Equal(t, codes.Unset, span.Status().Code)
+
+		entries := readEntries(t, &logBuf)
+		require.Len(t, entries, 1)
+		assert.Equal(t, traceID, entries[0]["trace_id"])
+		assert.Equal(t, span.SpanContext().SpanID().String(), entries[0]["span_id"])
+	})
+
+	t.Run("should start a new trace and mark server errors as failed", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		appLogger := logger.NewLogger(env, service, io.Discard)
+		handler := Tracing(tp, propagation.TraceContext{}, appLogger)(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}),
+		)
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/unknown", http.NoBody))
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, http.MethodPost, span.Name())
+		assert.False(t, span.Parent().IsValid())
+		assert.Equal(t, codes.Error, span.Status().Code)
+	})
+}
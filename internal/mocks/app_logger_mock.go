@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,6 +16,25 @@ func (m *MockAppLogger) Logger() zerolog.Logger {
 	return args.Get(0).(zerolog.Logger)
 }
 
+func (m *MockAppLogger) FromContext(ctx context.Context) zerolog.Logger {
+	args := m.Called(ctx)
+	return args.Get(0).(zerolog.Logger)
+}
+
+func (m *MockAppLogger) WithContext(ctx context.Context, key, value string) context.Context {
+	args := m.Called(ctx, key, value)
+	return args.Get(0).(context.Context)
+}
+
+func (m *MockAppLogger) Level() zerolog.Level {
+	args := m.Called()
+	return args.Get(0).(zerolog.Level)
+}
+
+func (m *MockAppLogger) SetLevel(level zerolog.Level) {
+	m.Called(level)
+}
+
 func (m *MockAppLogger) Fatal(err error, msg string) {
 	m.Called(err, msg)
 }
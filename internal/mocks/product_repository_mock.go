@@ -24,7 +24,7 @@ func (m *MockProductRepository) GetProductByID(
 
 func (m *MockProductRepository) ListProducts(
 	ctx context.Context,
-	opts shared.ListOptions,
+	opts shared.ProductListOptions,
 ) (*models.ListProductsResult, error) {
 	args := m.Called(ctx, opts)
 	return args.Get(0).(*models.ListProductsResult), args.Error(1)
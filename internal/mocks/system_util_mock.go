@@ -16,6 +16,16 @@ func (m *MockSystemUtil) CurrentTime() time.Time {
 	return args.Get(0).(time.Time)
 }
 
+func (m *MockSystemUtil) Now() time.Time {
+	args := m.Called()
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockSystemUtil) Since(start time.Time) time.Duration {
+	args := m.Called(start)
+	return args.Get(0).(time.Duration)
+}
+
 func (m *MockSystemUtil) NewUUID() uuid.UUID {
 	args := m.Called()
 	return args.Get(0).(uuid.UUID)
@@ -3,13 +3,48 @@ package models
 import (
 	"time"
 
+	"product-services/internal/shared"
+
 	"github.com/google/uuid"
 )
 
 // Common types
 type Pagination struct {
-	NextCursor time.Time
-	HasMore    bool
+	NextCursor  *shared.Cursor
+	PrevCursor  *shared.Cursor
+	HasMore     bool
+	HasPrevious bool
+}
+
+// NewPagination builds the pagination of a page listed with listOptions and
+// ordered by the keyset orders. hasExtra reports whether the scan found rows
+// beyond the page in the scan direction.
+func NewPagination[T shared.Sortable](
+	page []T,
+	orders []shared.SortOrder,
+	listOptions shared.ListOptions,
+	hasExtra bool,
+) Pagination {
+	var pagination Pagination
+	if len(page) == 0 {
+		return pagination
+	}
+
+	if listOptions.Backward() {
+		pagination.HasPrevious = hasExtra
+		pagination.HasMore = listOptions.Cursor != nil
+	} else {
+		pagination.HasMore = hasExtra
+		pagination.HasPrevious = listOptions.Cursor != nil
+	}
+
+	if pagination.HasMore {
+		pagination.NextCursor = shared.NewCursor(orders, page[len(page)-1])
+	}
+	if pagination.HasPrevious {
+		pagination.PrevCursor = shared.NewCursor(orders, page[0])
+	}
+	return pagination
 }
 
 type TimeStamps struct {
@@ -25,6 +60,31 @@ type Category struct {
 	TimeStamps
 }
 
+// CategorySortFields whitelists the fields categories can be sorted by.
+var CategorySortFields = []string{
+	shared.SortFieldName,
+	shared.SortFieldCreatedAt,
+	shared.SortFieldUpdatedAt,
+}
+
+// SortValue returns the value of a sortable category field.
+func (c *Category) SortValue(field string) any {
+	switch field {
+	case shared.SortFieldName:
+		return c.Name
+	case shared.SortFieldCreatedAt:
+		return c.CreatedAt
+	case shared.SortFieldUpdatedAt:
+		return c.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func (c *Category) SortID() uuid.UUID {
+	return c.ID
+}
+
 type ListCategoriesResult struct {
 	Categories []*Category
 	Pagination
@@ -47,6 +107,37 @@ type Product struct {
 	TimeStamps
 }
 
+// ProductSortFields whitelists the fields products can be sorted by.
+var ProductSortFields = []string{
+	shared.SortFieldName,
+	shared.SortFieldPrice,
+	shared.SortFieldQuantity,
+	shared.SortFieldCreatedAt,
+	shared.SortFieldUpdatedAt,
+}
+
+// SortValue returns the value of a sortable product field.
+func (p *Product) SortValue(field string) any {
+	switch field {
+	case shared.SortFieldName:
+		return p.Name
+	case shared.SortFieldPrice:
+		return p.Price
+	case shared.SortFieldQuantity:
+		return p.Quantity
+	case shared.SortFieldCreatedAt:
+		return p.CreatedAt
+	case shared.SortFieldUpdatedAt:
+		return p.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func (p *Product) SortID() uuid.UUID {
+	return p.ID
+}
+
 type ListProductsResult struct {
 	Products []*Product
 	Pagination
@@ -57,6 +148,6 @@ type ProductRequest struct {
 	Description string    `json:"description" validate:"omitempty,max=255"`
 	ImageURL    string    `json:"imageUrl"    validate:"omitempty,max=255"`
 	CategoryID  uuid.UUID `json:"categoryID"  validate:"required"`
-	Price       float64   `json:"price"       validate:"required"`
-	Quantity    int       `json:"quantity"    validate:"required"`
+	Price       float64   `json:"price"       validate:"gte=0"`
+	Quantity    int       `json:"quantity"    validate:"gte=0"`
 }
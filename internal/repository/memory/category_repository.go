@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepository struct {
+	store *Store
+}
+
+func NewCategoryRepository(store *Store) interfaces.CategoryRepository {
+	return &CategoryRepository{store: store}
+}
+
+func (r *CategoryRepository) GetCategoryByID(
+	_ context.Context,
+	id uuid.UUID,
+) (*models.Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	category, ok := r.store.categories[id]
+	if !ok {
+		return nil, shared.Errorf(shared.KindNotFound, "category `%s` does not exist", id)
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) ListCategories(
+	_ context.Context,
+	listOptions shared.ListOptions,
+) (*models.ListCategoriesResult, error) {
+	orders, err := listOptions.Keyset(models.CategorySortFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories, error: %w", err)
+	}
+
+	r.store.mu.RLock()
+	ranked := make([]shared.Ranked[*models.Category], 0, len(r.store.categories))
+	if listOptions.Query != "" {
+		for id, rank := range r.store.categoriesIndex.search(listOptions.Query) {
+			category := r.store.categories[id]
+			ranked = append(ranked, shared.Ranked[*models.Category]{Item: &category, Rank: rank})
+		}
+	} else {
+		for _, category := range r.store.categories {
+			ranked = append(ranked, shared.Ranked[*models.Category]{Item: &category})
+		}
+	}
+	r.store.mu.RUnlock()
+
+	page, hasExtra := paginate(ranked, orders, listOptions)
+	return &models.ListCategoriesResult{
+		Categories: shared.Items(page),
+		Pagination: models.NewPagination(page, orders, listOptions, hasExtra),
+	}, nil
+}
+
+func (r *CategoryRepository) CreateCategory(_ context.Context, category *models.Category) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.categories[category.ID]; ok {
+		return shared.Errorf(shared.KindConflict, "category `%s` already exists", category.ID)
+	}
+	if err := r.checkUniqueName(category); err != nil {
+		return err
+	}
+
+	r.store.categories[category.ID] = *category
+	r.store.categoriesIndex.add(category.ID, category.Name, category.Description)
+	return nil
+}
+
+// UpdateCategory updates the name and description of an existing category
+// and fills category.CreatedAt from the stored value.
+func (r *CategoryRepository) UpdateCategory(_ context.Context, category *models.Category) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.categories[category.ID]
+	if !ok {
+		return shared.Errorf(shared.KindNotFound, "category `%s` does not exist", category.ID)
+	}
+	if err := r.checkUniqueName(category); err != nil {
+		return err
+	}
+
+	stored.Name = category.Name
+	stored.Description = category.Description
+	stored.UpdatedAt = category.UpdatedAt
+	r.store.categories[category.ID] = stored
+	r.store.categoriesIndex.add(stored.ID, stored.Name, stored.Description)
+
+	category.CreatedAt = stored.CreatedAt
+	return nil
+}
+
+func (r *CategoryRepository) DeleteCategory(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.categories[id]; !ok {
+		return shared.Errorf(shared.KindNotFound, "category `%s` does not exist", id)
+	}
+	for _, product := range r.store.products {
+		if product.CategoryID == id {
+			return shared.Errorf(shared.KindConflict, "category `%s` is referenced by products", id)
+		}
+	}
+
+	delete(r.store.categories, id)
+	r.store.categoriesIndex.remove(id)
+	return nil
+}
+
+// checkUniqueName mirrors the unique constraint on categories.name.
+// The caller must hold the store lock.
+func (r *CategoryRepository) checkUniqueName(category *models.Category) error {
+	for id, stored := range r.store.categories {
+		if id != category.ID && stored.Name == category.Name {
+			return shared.Errorf(shared.KindConflict, "category name `%s` already exists", category.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package memory
+
+import (
+	"testing"
+
+	"product-services/internal/repository/repotest"
+)
+
+func newRepositories(_ *testing.T) repotest.Repositories {
+	store := NewStore()
+	return repotest.Repositories{
+		Categories: NewCategoryRepository(store),
+		Products:   NewProductRepository(store),
+	}
+}
+
+func TestCategoryRepositoryConformance(t *testing.T) {
+	repotest.RunCategoryRepositorySuite(t, newRepositories)
+}
+
+func TestProductRepositoryConformance(t *testing.T) {
+	repotest.RunProductRepositorySuite(t, newRepositories)
+}
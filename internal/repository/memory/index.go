@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"slices"
+
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// Field weights mirror the default ts_rank weights of the `A` (name) and `B`
+// (description) labels used by the SQL backend.
+const (
+	nameWeight        = 1.0
+	descriptionWeight = 0.4
+)
+
+// posting records how often a term occurs in a document's fields.
+type posting struct {
+	name        int
+	description int
+}
+
+// invertedIndex maps search terms to the documents containing them. It is
+// not safe for concurrent use; the store lock guards it.
+type invertedIndex struct {
+	postings map[string]map[uuid.UUID]posting
+	terms    map[uuid.UUID][]string
+}
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{
+		postings: make(map[string]map[uuid.UUID]posting),
+		terms:    make(map[uuid.UUID][]string),
+	}
+}
+
+// add indexes a document, replacing any previous version of it.
+func (idx *invertedIndex) add(id uuid.UUID, name, description string) {
+	idx.remove(id)
+
+	postings := make(map[string]posting)
+	for _, term := range shared.Tokenize(name) {
+		p := postings[term]
+		p.name++
+		postings[term] = p
+	}
+	for _, term := range shared.Tokenize(description) {
+		p := postings[term]
+		p.description++
+		postings[term] = p
+	}
+
+	terms := make([]string, 0, len(postings))
+	for term, p := range postings {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[uuid.UUID]posting)
+		}
+		idx.postings[term][id] = p
+		terms = append(terms, term)
+	}
+	idx.terms[id] = terms
+}
+
+func (idx *invertedIndex) remove(id uuid.UUID) {
+	for _, term := range idx.terms[id] {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.terms, id)
+}
+
+// search returns the rank of every document containing all terms of query.
+// The rank sums the weighted occurrences of each term.
+func (idx *invertedIndex) search(query string) map[uuid.UUID]float64 {
+	terms := shared.Tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+	slices.Sort(terms)
+	terms = slices.Compact(terms)
+
+	var ranks map[uuid.UUID]float64
+	for _, term := range terms {
+		postings := idx.postings[term]
+		next := make(map[uuid.UUID]float64, len(postings))
+		for id, p := range postings {
+			if ranks != nil {
+				if _, ok := ranks[id]; !ok {
+					continue
+				}
+			}
+			next[id] = ranks[id] + float64(p.name)*nameWeight + float64(p.description)*descriptionWeight
+		}
+		ranks = next
+	}
+	return ranks
+}
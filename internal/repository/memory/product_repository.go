@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+type ProductRepository struct {
+	store *Store
+}
+
+func NewProductRepository(store *Store) interfaces.ProductRepository {
+	return &ProductRepository{store: store}
+}
+
+func (r *ProductRepository) GetProductByID(
+	_ context.Context,
+	id uuid.UUID,
+) (*models.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.products[id]
+	if !ok {
+		return nil, shared.Errorf(shared.KindNotFound, "product `%s` does not exist", id)
+	}
+	return &product, nil
+}
+
+func (r *ProductRepository) ListProducts(
+	_ context.Context,
+	listOptions shared.ProductListOptions,
+) (*models.ListProductsResult, error) {
+	orders, err := listOptions.Keyset(models.ProductSortFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products, error: %w", err)
+	}
+
+	r.store.mu.RLock()
+	ranked := make([]shared.Ranked[*models.Product], 0, len(r.store.products))
+	if listOptions.Query != "" {
+		for id, rank := range r.store.productsIndex.search(listOptions.Query) {
+			product := r.store.products[id]
+			if matchesProductFilter(&product, listOptions.Filter) {
+				ranked = append(ranked, shared.Ranked[*models.Product]{Item: &product, Rank: rank})
+			}
+		}
+	} else {
+		for _, product := range r.store.products {
+			if matchesProductFilter(&product, listOptions.Filter) {
+				ranked = append(ranked, shared.Ranked[*models.Product]{Item: &product})
+			}
+		}
+	}
+	r.store.mu.RUnlock()
+
+	page, hasExtra := paginate(ranked, orders, listOptions.ListOptions)
+	return &models.ListProductsResult{
+		Products:   shared.Items(page),
+		Pagination: models.NewPagination(page, orders, listOptions.ListOptions, hasExtra),
+	}, nil
+}
+
+// matchesProductFilter mirrors the predicates of the SQL backend.
+func matchesProductFilter(product *models.Product, filter shared.ProductFilter) bool {
+	switch {
+	case filter.CategoryID != nil && product.CategoryID != *filter.CategoryID:
+		return false
+	case filter.MinPrice != nil && product.Price < *filter.MinPrice:
+		return false
+	case filter.MaxPrice != nil && product.Price > *filter.MaxPrice:
+		return false
+	case filter.InStock != nil && *filter.InStock != shared.IsInStock(product.Quantity):
+		return false
+	default:
+		return true
+	}
+}
+
+func (r *ProductRepository) CreateProduct(_ context.Context, product *models.Product) error {
+	if err := checkProductConstraints(product); err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[product.ID]; ok {
+		return shared.Errorf(shared.KindConflict, "product `%s` already exists", product.ID)
+	}
+	if err := r.checkCategoryExists(product.CategoryID); err != nil {
+		return err
+	}
+
+	r.store.products[product.ID] = *product
+	r.store.productsIndex.add(product.ID, product.Name, product.Description)
+	return nil
+}
+
+// UpdateProduct updates the mutable fields of an existing product and fills
+// product.CreatedAt from the stored value.
+func (r *ProductRepository) UpdateProduct(_ context.Context, product *models.Product) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.products[product.ID]
+	if !ok {
+		return shared.Errorf(shared.KindNotFound, "product `%s` does not exist", product.ID)
+	}
+	if err := checkProductConstraints(product); err != nil {
+		return err
+	}
+	if err := r.checkCategoryExists(product.CategoryID); err != nil {
+		return err
+	}
+
+	updated := *product
+	updated.CreatedAt = stored.CreatedAt
+	r.store.products[product.ID] = updated
+	r.store.productsIndex.add(updated.ID, updated.Name, updated.Description)
+
+	product.CreatedAt = stored.CreatedAt
+	return nil
+}
+
+func (r *ProductRepository) DeleteProduct(_ context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[id]; !ok {
+		return shared.Errorf(shared.KindNotFound, "product `%s` does not exist", id)
+	}
+
+	delete(r.store.products, id)
+	r.store.productsIndex.remove(id)
+	return nil
+}
+
+// checkProductConstraints mirrors the products_price_check and
+// products_quantity_check constraints.
+func checkProductConstraints(product *models.Product) error {
+	switch {
+	case product.Price < 0:
+		return shared.Errorf(shared.KindValidation, "product price must not be negative, got %v", product.Price)
+	case product.Quantity < 0:
+		return shared.Errorf(shared.KindValidation, "product quantity must not be negative, got %d", product.Quantity)
+	default:
+		return nil
+	}
+}
+
+// checkCategoryExists mirrors the foreign key on products.category_id.
+// The caller must hold the store lock.
+func (r *ProductRepository) checkCategoryExists(categoryID uuid.UUID) error {
+	if _, ok := r.store.categories[categoryID]; !ok {
+		return shared.Errorf(shared.KindConflict, "category `%s` does not exist", categoryID)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var base = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestStoreIsolation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not expose stored categories to callers", func(t *testing.T) {
+		repo := NewCategoryRepository(NewStore())
+		category := &models.Category{
+			ID:         uuid.New(),
+			Name:       "Books",
+			TimeStamps: models.TimeStamps{CreatedAt: base, UpdatedAt: base},
+		}
+		require.NoError(t, repo.CreateCategory(ctx, category))
+
+		category.Name = "Mutated"
+		stored, err := repo.GetCategoryByID(ctx, category.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Books", stored.Name)
+
+		stored.Name = "Mutated again"
+		result, err := repo.ListCategories(ctx, shared.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "Books", result.Categories[0].Name)
+	})
+
+	t.Run("should not expose stored products to callers", func(t *testing.T) {
+		store := NewStore()
+		category := &models.Category{ID: uuid.New(), Name: "Books"}
+		require.NoError(t, NewCategoryRepository(store).CreateCategory(ctx, category))
+
+		repo := NewProductRepository(store)
+		product := &models.Product{ID: uuid.New(), Name: "Gopher", CategoryID: category.ID, Price: 1}
+		require.NoError(t, repo.CreateProduct(ctx, product))
+
+		product.Price = 100
+		stored, err := repo.GetProductByID(ctx, product.ID)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, stored.Price, 0.001)
+	})
+}
@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// Store holds the in-memory state shared by the category and product
+// repositories so that referential integrity can be enforced across both.
+type Store struct {
+	mu              sync.RWMutex
+	categories      map[uuid.UUID]models.Category
+	products        map[uuid.UUID]models.Product
+	categoriesIndex *invertedIndex
+	productsIndex   *invertedIndex
+}
+
+func NewStore() *Store {
+	return &Store{
+		categories:      make(map[uuid.UUID]models.Category),
+		products:        make(map[uuid.UUID]models.Product),
+		categoriesIndex: newInvertedIndex(),
+		productsIndex:   newInvertedIndex(),
+	}
+}
+
+// paginate selects the page of items described by listOptions. Items are
+// ordered by the keyset orders followed by ID to mirror the SQL backend. The
+// scan runs away from listOptions.Cursor, in reverse when paging backward, and
+// the page is returned in the requested order together with whether the scan
+// found more items beyond it.
+func paginate[T shared.Sortable](
+	items []T,
+	orders []shared.SortOrder,
+	listOptions shared.ListOptions,
+) ([]T, bool) {
+	type keyed struct {
+		item   T
+		values []any
+	}
+
+	backward := listOptions.Backward()
+	scanCompare := func(a []any, aID uuid.UUID, b []any, bID uuid.UUID) int {
+		c := compareKeys(orders, a, aID, b, bID)
+		if backward {
+			return -c
+		}
+		return c
+	}
+
+	cursor := listOptions.Cursor
+	entries := make([]keyed, 0, len(items))
+	for _, item := range items {
+		values := sortValues(orders, item)
+		if cursor != nil && scanCompare(values, item.SortID(), cursor.Values, cursor.ID) <= 0 {
+			continue
+		}
+		entries = append(entries, keyed{item: item, values: values})
+	}
+
+	slices.SortFunc(entries, func(a, b keyed) int {
+		return scanCompare(a.values, a.item.SortID(), b.values, b.item.SortID())
+	})
+
+	limit := listOptions.EffectiveLimit()
+	page := make([]T, 0, min(len(entries), limit))
+	for _, entry := range entries[:min(len(entries), limit)] {
+		page = append(page, entry.item)
+	}
+	if backward {
+		slices.Reverse(page)
+	}
+	return page, len(entries) > limit
+}
+
+func sortValues(orders []shared.SortOrder, item shared.Sortable) []any {
+	values := make([]any, 0, len(orders))
+	for _, order := range orders {
+		values = append(values, item.SortValue(order.Field))
+	}
+	return values
+}
+
+// compareKeys compares two sort keys field by field, honoring each order's
+// direction, and falls back to the IDs.
+func compareKeys(orders []shared.SortOrder, a []any, aID uuid.UUID, b []any, bID uuid.UUID) int {
+	for i, order := range orders {
+		c := compareValues(a[i], b[i])
+		if order.Direction == shared.SortDesc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareUUID(aID, bID)
+}
+
+// compareValues compares two sort values of the same type.
+func compareValues(a, b any) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case int:
+		return cmp.Compare(a, b.(int))
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	default:
+		panic(fmt.Sprintf("memory: unsupported sort value type %T", a))
+	}
+}
+
+// compareUUID orders UUIDs bytewise, matching PostgreSQL's uuid ordering.
+func compareUUID(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
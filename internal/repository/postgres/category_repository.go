@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+const categoryColumns = "id, name, description, created_at, updated_at"
+
+// categorySortColumns maps the sortable category fields to their columns.
+var categorySortColumns = map[string]string{
+	shared.SortFieldName:      "name",
+	shared.SortFieldCreatedAt: "created_at",
+	shared.SortFieldUpdatedAt: "updated_at",
+}
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) interfaces.CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) GetCategoryByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*models.Category, error) {
+	const query = "SELECT " + categoryColumns + " FROM categories WHERE id = $1"
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category `%s`, error: %w", id, mapError(err))
+	}
+	return category, nil
+}
+
+func (r *CategoryRepository) ListCategories(
+	ctx context.Context,
+	listOptions shared.ListOptions,
+) (*models.ListCategoriesResult, error) {
+	orders, err := listOptions.Keyset(models.CategorySortFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories, error: %w", err)
+	}
+
+	q := newListQuery(categoryColumns, "categories")
+	columns := categorySortColumns
+	if listOptions.Query != "" {
+		columns = q.search(listOptions.Query, columns)
+	}
+	if err := q.keyset(orders, columns, listOptions.Cursor, listOptions.Backward()); err != nil {
+		return nil, fmt.Errorf("failed to list categories, error: %w", err)
+	}
+
+	limit := listOptions.EffectiveLimit()
+	rows, err := r.db.QueryContext(ctx, q.build(limit+1), q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories, error: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	ranked := make([]shared.Ranked[*models.Category], 0, limit+1)
+	for rows.Next() {
+		var rank float64
+		var extra []any
+		if listOptions.Query != "" {
+			extra = append(extra, &rank)
+		}
+
+		category, err := scanCategory(rows, extra...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category, error: %w", err)
+		}
+		ranked = append(ranked, shared.Ranked[*models.Category]{Item: category, Rank: rank})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list categories, error: %w", mapError(err))
+	}
+
+	hasExtra := len(ranked) > limit
+	if hasExtra {
+		ranked = ranked[:limit]
+	}
+	if listOptions.Backward() {
+		slices.Reverse(ranked)
+	}
+
+	return &models.ListCategoriesResult{
+		Categories: shared.Items(ranked),
+		Pagination: models.NewPagination(ranked, orders, listOptions, hasExtra),
+	}, nil
+}
+
+func (r *CategoryRepository) CreateCategory(ctx context.Context, category *models.Category) error {
+	const query = `INSERT INTO categories (` + categoryColumns + `)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		category.ID,
+		category.Name,
+		category.Description,
+		category.CreatedAt,
+		category.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create category `%s`, error: %w", category.ID, mapError(err))
+	}
+	return nil
+}
+
+// UpdateCategory updates the name and description of an existing category
+// and fills category.CreatedAt from the stored row.
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, category *models.Category) error {
+	const query = `UPDATE categories SET name = $2, description = $3, updated_at = $4
+		WHERE id = $1 RETURNING created_at`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		category.ID,
+		category.Name,
+		category.Description,
+		category.UpdatedAt,
+	).Scan(&category.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update category `%s`, error: %w", category.ID, mapError(err))
+	}
+	return nil
+}
+
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	const query = "DELETE FROM categories WHERE id = $1"
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete category `%s`, error: %w", id, mapError(err))
+	}
+	return checkRowsAffected(res, "category", id)
+}
+
+// scanCategory scans the category columns followed by the extra destinations.
+func scanCategory(row rowScanner, extra ...any) (*models.Category, error) {
+	var category models.Category
+	dest := []any{
+		&category.ID,
+		&category.Name,
+		&category.Description,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCategoryOne = models.Category{
+		ID:          uuid.MustParse("f2aa335f-6f91-4d4d-8057-53b0009bc376"),
+		Name:        "Test Category A",
+		Description: "Test category a description",
+		TimeStamps: models.TimeStamps{
+			CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	testCategoryTwo = models.Category{
+		ID:          uuid.MustParse("b12f2176-28ca-4acf-85b9-cc97ca1b3cf6"),
+		Name:        "Test Category B",
+		Description: "Test category B description",
+		TimeStamps: models.TimeStamps{
+			CreatedAt: time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC),
+		},
+	}
+)
+
+func newMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, mock
+}
+
+func categoryRows(categories ...models.Category) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "created_at", "updated_at"})
+	for _, c := range categories {
+		rows.AddRow(c.ID, c.Name, c.Description, c.CreatedAt, c.UpdatedAt)
+	}
+	return rows
+}
+
+func TestCategoryRepositoryGetCategoryByID(t *testing.T) {
+	const query = "SELECT id, name, description, created_at, updated_at FROM categories WHERE id = $1"
+
+	t.Run("should return category if it exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testCategoryOne.ID).
+			WillReturnRows(categoryRows(testCategoryOne))
+
+		category, err := repo.GetCategoryByID(context.Background(), testCategoryOne.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &testCategoryOne, category)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found if category does not exist", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testCategoryOne.ID).
+			WillReturnRows(categoryRows())
+
+		category, err := repo.GetCategoryByID(context.Background(), testCategoryOne.ID)
+		assert.Nil(t, category)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepositoryListCategories(t *testing.T) {
+	t.Run("should apply cursor, sort orders and limit", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		sortOrders := []shared.SortOrder{{Field: shared.SortFieldName, Direction: shared.SortDesc}}
+		orders := append(sortOrders, shared.SortOrder{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc})
+		cursor := shared.NewCursor(orders, &models.Category{
+			ID:         uuid.MustParse("5a1d8a4e-7c65-4e2b-9b0e-3f1c6a0e9d21"),
+			Name:       "Test Category C",
+			TimeStamps: models.TimeStamps{CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		})
+
+		query := "SELECT id, name, description, created_at, updated_at FROM categories " +
+			"WHERE ((name < $1) OR (name = $1 AND created_at > $2) OR (name = $1 AND created_at = $2 AND id > $3)) " +
+			"ORDER BY name DESC, created_at ASC, id ASC LIMIT $4"
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(cursor.Values[0], cursor.Values[1], cursor.ID, 2).
+			WillReturnRows(categoryRows(testCategoryTwo, testCategoryOne))
+
+		result, err := repo.ListCategories(context.Background(), shared.ListOptions{
+			Cursor:     cursor,
+			Limit:      1,
+			SortOrders: sortOrders,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.Category{&testCategoryTwo}, result.Categories)
+		assert.True(t, result.HasMore)
+		assert.Equal(t, shared.NewCursor(orders, &testCategoryTwo), result.NextCursor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should clamp limit and report no more pages", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		query := "SELECT id, name, description, created_at, updated_at FROM categories " +
+			"ORDER BY created_at ASC, id ASC LIMIT $1"
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(shared.MaxListLimit + 1).
+			WillReturnRows(categoryRows(testCategoryOne, testCategoryTwo))
+
+		result, err := repo.ListCategories(context.Background(), shared.ListOptions{Limit: 1000})
+		assert.NoError(t, err)
+		assert.Len(t, result.Categories, 2)
+		assert.False(t, result.HasMore)
+		assert.Nil(t, result.NextCursor)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should reject sort fields outside the whitelist", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		result, err := repo.ListCategories(context.Background(), shared.ListOptions{
+			SortOrders: []shared.SortOrder{{Field: "name; DROP TABLE categories", Direction: shared.SortAsc}},
+		})
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, shared.ErrValidation)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepositoryCreateCategory(t *testing.T) {
+	const query = "INSERT INTO categories (id, name, description, created_at, updated_at)"
+
+	t.Run("should insert category", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(
+				testCategoryOne.ID,
+				testCategoryOne.Name,
+				testCategoryOne.Description,
+				testCategoryOne.CreatedAt,
+				testCategoryOne.UpdatedAt,
+			).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		category := testCategoryOne
+		assert.NoError(t, repo.CreateCategory(context.Background(), &category))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return conflict on unique violation", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta(query)).
+			WillReturnError(&pq.Error{Code: pgUniqueViolation, Message: "duplicate key"})
+
+		category := testCategoryOne
+		err := repo.CreateCategory(context.Background(), &category)
+		assert.ErrorIs(t, err, shared.ErrConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepositoryUpdateCategory(t *testing.T) {
+	const query = "UPDATE categories SET name = $2, description = $3, updated_at = $4"
+
+	t.Run("should update category and fill created at", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		updatedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testCategoryOne.ID, "Renamed", "", updatedAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(testCategoryOne.CreatedAt))
+
+		category := models.Category{
+			ID:         testCategoryOne.ID,
+			Name:       "Renamed",
+			TimeStamps: models.TimeStamps{UpdatedAt: updatedAt},
+		}
+		assert.NoError(t, repo.UpdateCategory(context.Background(), &category))
+		assert.Equal(t, testCategoryOne.CreatedAt, category.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found if category does not exist", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		category := testCategoryOne
+		err := repo.UpdateCategory(context.Background(), &category)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepositoryDeleteCategory(t *testing.T) {
+	const query = "DELETE FROM categories WHERE id = $1"
+
+	t.Run("should return not found if no row was deleted", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(testCategoryOne.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteCategory(context.Background(), testCategoryOne.ID)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return conflict if category is still referenced", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(testCategoryOne.ID).
+			WillReturnError(&pq.Error{Code: pgForeignKeyViolation, Message: "still referenced"})
+
+		err := repo.DeleteCategory(context.Background(), testCategoryOne.ID)
+		assert.ErrorIs(t, err, shared.ErrConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should propagate unexpected errors", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewCategoryRepository(db)
+
+		dbErr := errors.New("connection reset")
+		mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnError(dbErr)
+
+		err := repo.DeleteCategory(context.Background(), testCategoryOne.ID)
+		assert.ErrorIs(t, err, dbErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	// Register the "postgres" database/sql driver.
+	_ "github.com/lib/pq"
+)
+
+const (
+	DriverName = "postgres"
+
+	DefaultMaxOpenConns    = 25
+	DefaultMaxIdleConns    = 25
+	DefaultConnMaxLifetime = 30 * time.Minute
+	DefaultConnMaxIdleTime = 5 * time.Minute
+)
+
+// Config holds the connection pool settings for PostgreSQL.
+type Config struct {
+	DSN             string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+// Open opens a connection pool to PostgreSQL and verifies it with a ping.
+func Open(ctx context.Context, cfg Config) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database, error: %w", err)
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to database, error: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"product-services/internal/shared"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+	pgQueryCanceled       = "57014"
+	pgAdminShutdown       = "57P01"
+	pgCannotConnectNow    = "57P03"
+
+	// Error classes covering connection failures and exhausted resources.
+	pgClassConnectionException   = "08"
+	pgClassInsufficientResources = "53"
+)
+
+// mapError translates driver errors into the typed shared repository errors,
+// keeping the driver error as the cause.
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return shared.ErrNotFound
+	case errors.Is(err, context.DeadlineExceeded):
+		return &shared.Error{Kind: shared.KindTimeout, Message: "database query timed out", Err: err}
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone):
+		return &shared.Error{Kind: shared.KindUnavailable, Message: "database connection lost", Err: err}
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch code, class := pqErr.Code, pqErr.Code.Class(); {
+		case code == pgUniqueViolation, code == pgForeignKeyViolation:
+			return &shared.Error{Kind: shared.KindConflict, Message: pqErr.Message, Err: err}
+		case code == pgCheckViolation:
+			return &shared.Error{Kind: shared.KindValidation, Message: pqErr.Message, Err: err}
+		case code == pgQueryCanceled:
+			return &shared.Error{Kind: shared.KindTimeout, Message: pqErr.Message, Err: err}
+		case code == pgAdminShutdown, code == pgCannotConnectNow,
+			class == pgClassConnectionException, class == pgClassInsufficientResources:
+			return &shared.Error{Kind: shared.KindUnavailable, Message: pqErr.Message, Err: err}
+		}
+		return err
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return &shared.Error{Kind: shared.KindUnavailable, Message: "database unreachable", Err: err}
+	}
+
+	return err
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"product-services/internal/shared"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		kind shared.Kind
+	}{
+		{"no rows", sql.ErrNoRows, shared.KindNotFound},
+		{"unique violation", &pq.Error{Code: pgUniqueViolation}, shared.KindConflict},
+		{"foreign key violation", &pq.Error{Code: pgForeignKeyViolation}, shared.KindConflict},
+		{"check violation", &pq.Error{Code: pgCheckViolation}, shared.KindValidation},
+		{"query canceled", &pq.Error{Code: pgQueryCanceled}, shared.KindTimeout},
+		{"deadline exceeded", context.DeadlineExceeded, shared.KindTimeout},
+		{"connection failure", &pq.Error{Code: "08006"}, shared.KindUnavailable},
+		{"too many connections", &pq.Error{Code: "53300"}, shared.KindUnavailable},
+		{"bad connection", driver.ErrBadConn, shared.KindUnavailable},
+		{"syntax error", &pq.Error{Code: "42601"}, shared.KindInternal},
+		{"unknown", errors.New("boom"), shared.KindInternal},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mapError(tc.err)
+			assert.Equal(t, tc.kind, shared.KindOf(err))
+			if tc.kind != shared.KindNotFound {
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+	"product-services/internal/repository/repotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testDB connects to the PostgreSQL instance referenced by POSTGRES_TEST_DSN
+// and recreates the schema through the migrator. The test is skipped when no
+// database is available.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	db, err := Open(ctx, Config{DSN: dsn, MaxOpenConns: 5, MaxIdleConns: 5})
+	if err != nil {
+		t.Skipf("PostgreSQL is not available: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	var logBuf bytes.Buffer
+	migrator, err := NewMigrator(db, Migrations, logger.NewLogger("prod", "ProductService", &logBuf))
+	require.NoError(t, err)
+	require.NoError(t, migrator.To(ctx, 0))
+	require.NoError(t, migrator.Up(ctx))
+	return db
+}
+
+func newRepositories(t *testing.T) repotest.Repositories {
+	db := testDB(t)
+	return repotest.Repositories{
+		Categories: NewCategoryRepository(db),
+		Products:   NewProductRepository(db),
+	}
+}
+
+func TestCategoryRepositoryConformance(t *testing.T) {
+	repotest.RunCategoryRepositorySuite(t, newRepositories)
+}
+
+func TestProductRepositoryConformance(t *testing.T) {
+	repotest.RunProductRepositorySuite(t, newRepositories)
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS categories (
+    id          UUID         PRIMARY KEY,
+    name        VARCHAR(100) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT categories_name_key UNIQUE (name)
+);
+
+-- Supports keyset pagination ordered by (created_at, id).
+CREATE INDEX IF NOT EXISTS categories_created_at_id_idx ON categories (created_at, id);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS products (
+    id          UUID           PRIMARY KEY,
+    name        VARCHAR(100)   NOT NULL,
+    description VARCHAR(255)   NOT NULL DEFAULT '',
+    image_url   VARCHAR(255)   NOT NULL DEFAULT '',
+    category_id UUID           NOT NULL,
+    price       NUMERIC(12, 2) NOT NULL,
+    quantity    INTEGER        NOT NULL,
+    created_at  TIMESTAMPTZ    NOT NULL,
+    updated_at  TIMESTAMPTZ    NOT NULL,
+    CONSTRAINT products_category_id_fkey
+        FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE RESTRICT,
+    CONSTRAINT products_price_check CHECK (price >= 0),
+    CONSTRAINT products_quantity_check CHECK (quantity >= 0)
+);
+
+-- Supports keyset pagination ordered by (created_at, id).
+CREATE INDEX IF NOT EXISTS products_created_at_id_idx ON products (created_at, id);
+
+-- Postgres does not index foreign keys automatically; this keeps category
+-- deletes and per-category lookups from scanning the whole table.
+CREATE INDEX IF NOT EXISTS products_category_id_idx ON products (category_id);
@@ -0,0 +1,17 @@
+-- Full-text search over names and descriptions. The `simple` configuration
+-- lowercases words without stemming; names rank above descriptions.
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', name), 'A') ||
+        setweight(to_tsvector('simple', description), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS categories_search_vector_idx ON categories USING GIN (search_vector);
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', name), 'A') ||
+        setweight(to_tsvector('simple', description), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS products_search_vector_idx ON products USING GIN (search_vector);
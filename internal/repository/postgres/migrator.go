@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"product-services/internal/interfaces"
+)
+
+// Migrations holds the embedded schema migrations. Files are named
+// `<version>_<name>.up.sql` and `<version>_<name>.down.sql`.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+const (
+	migrationsDir = "migrations"
+
+	// migrationLockID identifies the advisory lock that serializes migrators
+	// running against the same database.
+	migrationLockID = 7_431_002_118
+)
+
+var ErrUnknownMigrationVersion = errors.New("unknown migration version")
+
+// Migration is a single versioned schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Migrator applies and rolls back migrations, recording the applied versions
+// in the schema_migrations table.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     interfaces.AppLogger
+}
+
+// NewMigrator loads the migrations found in the `migrations` directory of fsys.
+func NewMigrator(db *sql.DB, fsys fs.FS, logger interfaces.AppLogger) (*Migrator, error) {
+	migrations, err := loadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, logger: logger}, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	if len(m.migrations) == 0 {
+		return nil
+	}
+	return m.To(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down rolls back the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			return nil
+		}
+
+		latest := slices.Max(applied)
+		target := int64(0)
+		for _, version := range applied {
+			if version < latest {
+				target = max(target, version)
+			}
+		}
+		return m.migrate(ctx, conn, applied, target)
+	})
+}
+
+// To migrates the schema up or down to version. Version 0 rolls back every
+// migration.
+func (m *Migrator) To(ctx context.Context, version int64) error {
+	if version != 0 && !slices.ContainsFunc(m.migrations, func(mig Migration) bool {
+		return mig.Version == version
+	}) {
+		return fmt.Errorf("%w: `%d`", ErrUnknownMigrationVersion, version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrate(ctx, conn, applied, version)
+	})
+}
+
+// Version returns the highest applied migration version, or 0 if none.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	var version int64
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			version = slices.Max(applied)
+		}
+		return nil
+	})
+	return version, err
+}
+
+// migrate applies pending migrations up to target in ascending order, then
+// rolls back applied migrations above target in descending order.
+func (m *Migrator) migrate(ctx context.Context, conn *sql.Conn, applied []int64, target int64) error {
+	for _, migration := range m.migrations {
+		if migration.Version <= target && !slices.Contains(applied, migration.Version) {
+			if err := m.apply(ctx, conn, migration, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version > target && slices.Contains(applied, migration.Version) {
+			if err := m.apply(ctx, conn, migration, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply runs a migration script and records it in a single transaction.
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration, up bool) error {
+	script, direction := migration.Up, "up"
+	record := "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)"
+	args := []any{migration.Version, migration.Name}
+	if !up {
+		script, direction = migration.Down, "down"
+		record = "DELETE FROM schema_migrations WHERE version = $1"
+		args = args[:1]
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration `%d`, error: %w", migration.Version, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("failed to run migration `%d_%s` %s, error: %w", migration.Version, migration.Name, direction, err)
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return fmt.Errorf("failed to record migration `%d`, error: %w", migration.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration `%d`, error: %w", migration.Version, err)
+	}
+
+	appLogger := m.logger.Logger()
+	appLogger.Info().
+		Int64("version", migration.Version).
+		Str("name", migration.Name).
+		Str("direction", direction).
+		Msg("Applied migration")
+	return nil
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory
+// lock, creating the schema_migrations table first if needed.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection, error: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock, error: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationLockID)
+	}()
+
+	const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT      PRIMARY KEY,
+		name       TEXT        NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table, error: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) ([]int64, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations, error: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []int64
+	for rows.Next() {
+		var version int64
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration, error: %w", err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, rows.Err()
+}
+
+// loadMigrations reads and pairs the up/down scripts, sorted by version.
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, migrationsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations, error: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		version, name, up, err := parseMigrationFileName(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(migrationsDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration `%s`, error: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration version `%d` has conflicting names `%s` and `%s`", version, migration.Name, name)
+		}
+		if up {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration `%d_%s` must have both up and down scripts", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return migrations, nil
+}
+
+// parseMigrationFileName splits `<version>_<name>.<up|down>.sql`.
+func parseMigrationFileName(fileName string) (int64, string, bool, error) {
+	base := strings.TrimSuffix(fileName, ".sql")
+
+	var up bool
+	switch {
+	case strings.HasSuffix(base, ".up"):
+		up, base = true, strings.TrimSuffix(base, ".up")
+	case strings.HasSuffix(base, ".down"):
+		base = strings.TrimSuffix(base, ".down")
+	default:
+		return 0, "", false, fmt.Errorf("invalid migration file name `%s`: missing .up or .down suffix", fileName)
+	}
+
+	versionStr, name, ok := strings.Cut(base, "_")
+	if !ok || name == "" {
+		return 0, "", false, fmt.Errorf("invalid migration file name `%s`: expected <version>_<name>", fileName)
+	}
+
+	version, err := strconv.ParseInt(versionStr, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, "", false, fmt.Errorf("invalid migration file name `%s`: version must be a positive integer", fileName)
+	}
+	return version, name, up, nil
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"product-services/internal/logger"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMigrations = fstest.MapFS{
+	"migrations/000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT)")},
+	"migrations/000001_create_a.down.sql": {Data: []byte("DROP TABLE a")},
+	"migrations/000002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INT)")},
+	"migrations/000002_create_b.down.sql": {Data: []byte("DROP TABLE b")},
+}
+
+func newTestMigrator(t *testing.T) (*Migrator, sqlmock.Sqlmock, *bytes.Buffer) {
+	t.Helper()
+	db, mock := newMockDB(t)
+
+	var logBuf bytes.Buffer
+	migrator, err := NewMigrator(db, testMigrations, logger.NewLogger("prod", "ProductService", &logBuf))
+	require.NoError(t, err)
+	return migrator, mock, &logBuf
+}
+
+func expectLockAndVersions(mock sqlmock.Sqlmock, applied ...int64) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+		WithArgs(migrationLockID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	rows := sqlmock.NewRows([]string{"version"})
+	for _, version := range applied {
+		rows.AddRow(version)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM schema_migrations ORDER BY version")).
+		WillReturnRows(rows)
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
+		WithArgs(migrationLockID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("should load embedded migrations in version order", func(t *testing.T) {
+		migrations, err := loadMigrations(Migrations)
+		require.NoError(t, err)
+		require.Len(t, migrations, 3)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "create_categories", migrations[0].Name)
+		assert.Equal(t, int64(2), migrations[1].Version)
+		assert.Equal(t, "create_products", migrations[1].Name)
+		assert.Contains(t, migrations[1].Up, "products_created_at_id_idx")
+		assert.Contains(t, migrations[1].Up, "products_category_id_idx")
+		assert.Equal(t, int64(3), migrations[2].Version)
+		assert.Equal(t, "add_search_vectors", migrations[2].Name)
+	})
+
+	t.Run("should reject migrations without a down script", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"migrations/000001_create_a.up.sql": {Data: []byte("CREATE TABLE a (id INT)")},
+		}
+		_, err := loadMigrations(fsys)
+		assert.EqualError(t, err, "migration `1_create_a` must have both up and down scripts")
+	})
+
+	t.Run("should reject malformed file names", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"migrations/createa.up.sql": {Data: []byte("CREATE TABLE a (id INT)")},
+		}
+		_, err := loadMigrations(fsys)
+		assert.EqualError(t, err, "invalid migration file name `createa.up.sql`: expected <version>_<name>")
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should apply pending migrations on up", func(t *testing.T) {
+		migrator, mock, logBuf := newTestMigrator(t)
+
+		expectLockAndVersions(mock, 1)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE b (id INT)")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, name) VALUES ($1, $2)")).
+			WithArgs(int64(2), "create_b").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		expectUnlock(mock)
+
+		require.NoError(t, migrator.Up(ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Contains(t, logBuf.String(), `"name":"create_b"`)
+	})
+
+	t.Run("should roll back the latest migration on down", func(t *testing.T) {
+		migrator, mock, _ := newTestMigrator(t)
+
+		expectLockAndVersions(mock, 1, 2)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("DROP TABLE b")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM schema_migrations WHERE version = $1")).
+			WithArgs(int64(2)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		expectUnlock(mock)
+
+		require.NoError(t, migrator.Down(ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should roll back the failed migration transaction", func(t *testing.T) {
+		migrator, mock, _ := newTestMigrator(t)
+
+		expectLockAndVersions(mock)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE a (id INT)")).
+			WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+		expectUnlock(mock)
+
+		err := migrator.To(ctx, 1)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should reject unknown target versions", func(t *testing.T) {
+		migrator, mock, _ := newTestMigrator(t)
+
+		err := migrator.To(ctx, 42)
+		assert.ErrorIs(t, err, ErrUnknownMigrationVersion)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should report the current version", func(t *testing.T) {
+		migrator, mock, _ := newTestMigrator(t)
+
+		expectLockAndVersions(mock, 1, 2)
+		expectUnlock(mock)
+
+		version, err := migrator.Version(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
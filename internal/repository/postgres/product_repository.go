@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+const productColumns = "id, name, description, image_url, category_id, price, quantity, created_at, updated_at"
+
+// productSortColumns maps the sortable product fields to their columns.
+var productSortColumns = map[string]string{
+	shared.SortFieldName:      "name",
+	shared.SortFieldPrice:     "price",
+	shared.SortFieldQuantity:  "quantity",
+	shared.SortFieldCreatedAt: "created_at",
+	shared.SortFieldUpdatedAt: "updated_at",
+}
+
+type ProductRepository struct {
+	db *sql.DB
+}
+
+func NewProductRepository(db *sql.DB) interfaces.ProductRepository {
+	return &ProductRepository{db: db}
+}
+
+func (r *ProductRepository) GetProductByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*models.Product, error) {
+	const query = "SELECT " + productColumns + " FROM products WHERE id = $1"
+
+	product, err := scanProduct(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product `%s`, error: %w", id, mapError(err))
+	}
+	return product, nil
+}
+
+func (r *ProductRepository) ListProducts(
+	ctx context.Context,
+	listOptions shared.ProductListOptions,
+) (*models.ListProductsResult, error) {
+	orders, err := listOptions.Keyset(models.ProductSortFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products, error: %w", err)
+	}
+
+	q := newListQuery(productColumns, "products")
+	filterProducts(q, listOptions.Filter)
+	columns := productSortColumns
+	if listOptions.Query != "" {
+		columns = q.search(listOptions.Query, columns)
+	}
+	if err := q.keyset(orders, columns, listOptions.Cursor, listOptions.Backward()); err != nil {
+		return nil, fmt.Errorf("failed to list products, error: %w", err)
+	}
+
+	limit := listOptions.EffectiveLimit()
+	rows, err := r.db.QueryContext(ctx, q.build(limit+1), q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products, error: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	ranked := make([]shared.Ranked[*models.Product], 0, limit+1)
+	for rows.Next() {
+		var rank float64
+		var extra []any
+		if listOptions.Query != "" {
+			extra = append(extra, &rank)
+		}
+
+		product, err := scanProduct(rows, extra...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product, error: %w", err)
+		}
+		ranked = append(ranked, shared.Ranked[*models.Product]{Item: product, Rank: rank})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list products, error: %w", mapError(err))
+	}
+
+	hasExtra := len(ranked) > limit
+	if hasExtra {
+		ranked = ranked[:limit]
+	}
+	if listOptions.Backward() {
+		slices.Reverse(ranked)
+	}
+
+	return &models.ListProductsResult{
+		Products:   shared.Items(ranked),
+		Pagination: models.NewPagination(ranked, orders, listOptions.ListOptions, hasExtra),
+	}, nil
+}
+
+// filterProducts translates filter into parameterized predicates.
+func filterProducts(q *listQuery, filter shared.ProductFilter) {
+	if filter.CategoryID != nil {
+		q.where("category_id = " + q.arg(*filter.CategoryID))
+	}
+	if filter.MinPrice != nil {
+		q.where("price >= " + q.arg(*filter.MinPrice))
+	}
+	if filter.MaxPrice != nil {
+		q.where("price <= " + q.arg(*filter.MaxPrice))
+	}
+	if filter.InStock != nil {
+		if *filter.InStock {
+			q.where("quantity >= " + q.arg(shared.MinInStockQuantity))
+		} else {
+			q.where("quantity < " + q.arg(shared.MinInStockQuantity))
+		}
+	}
+}
+
+func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.Product) error {
+	const query = `INSERT INTO products (` + productColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		product.ID,
+		product.Name,
+		product.Description,
+		product.ImageURL,
+		product.CategoryID,
+		product.Price,
+		product.Quantity,
+		product.CreatedAt,
+		product.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create product `%s`, error: %w", product.ID, mapError(err))
+	}
+	return nil
+}
+
+// UpdateProduct updates the mutable fields of an existing product and fills
+// product.CreatedAt from the stored row.
+func (r *ProductRepository) UpdateProduct(ctx context.Context, product *models.Product) error {
+	const query = `UPDATE products SET name = $2, description = $3, image_url = $4,
+		category_id = $5, price = $6, quantity = $7, updated_at = $8
+		WHERE id = $1 RETURNING created_at`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		product.ID,
+		product.Name,
+		product.Description,
+		product.ImageURL,
+		product.CategoryID,
+		product.Price,
+		product.Quantity,
+		product.UpdatedAt,
+	).Scan(&product.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update product `%s`, error: %w", product.ID, mapError(err))
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	const query = "DELETE FROM products WHERE id = $1"
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete product `%s`, error: %w", id, mapError(err))
+	}
+	return checkRowsAffected(res, "product", id)
+}
+
+// scanProduct scans the product columns followed by the extra destinations.
+func scanProduct(row rowScanner, extra ...any) (*models.Product, error) {
+	var product models.Product
+	dest := []any{
+		&product.ID,
+		&product.Name,
+		&product.Description,
+		&product.ImageURL,
+		&product.CategoryID,
+		&product.Price,
+		&product.Quantity,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var testProductOne = models.Product{
+	ID:          uuid.MustParse("3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"),
+	Name:        "Test Product A",
+	Description: "Test product a description",
+	ImageURL:    "https://example.com/a.png",
+	CategoryID:  testCategoryOne.ID,
+	Price:       9.99,
+	Quantity:    10,
+	TimeStamps: models.TimeStamps{
+		CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+func productRows(products ...models.Product) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "description", "image_url", "category_id",
+		"price", "quantity", "created_at", "updated_at",
+	})
+	for _, p := range products {
+		rows.AddRow(
+			p.ID, p.Name, p.Description, p.ImageURL, p.CategoryID,
+			p.Price, p.Quantity, p.CreatedAt, p.UpdatedAt,
+		)
+	}
+	return rows
+}
+
+func TestProductRepositoryGetProductByID(t *testing.T) {
+	const query = "SELECT " + productColumns + " FROM products WHERE id = $1"
+
+	t.Run("should return product if it exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testProductOne.ID).
+			WillReturnRows(productRows(testProductOne))
+
+		product, err := repo.GetProductByID(context.Background(), testProductOne.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &testProductOne, product)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found if product does not exist", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(productRows())
+
+		_, err := repo.GetProductByID(context.Background(), testProductOne.ID)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductRepositoryListProducts(t *testing.T) {
+	t.Run("should sort by whitelisted product fields", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		query := "SELECT " + productColumns + " FROM products " +
+			"ORDER BY price DESC, name ASC, created_at ASC, id ASC LIMIT $1"
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(11).
+			WillReturnRows(productRows(testProductOne))
+
+		result, err := repo.ListProducts(context.Background(), shared.ProductListOptions{ListOptions: shared.ListOptions{
+			Limit: 10,
+			SortOrders: []shared.SortOrder{
+				{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+				{Field: shared.SortFieldName, Direction: shared.SortAsc},
+			},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.Product{&testProductOne}, result.Products)
+		assert.False(t, result.HasMore)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should reverse the scan when paging backward", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		orders := []shared.SortOrder{
+			{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+			{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc},
+		}
+		testProductTwo := testProductOne
+		testProductTwo.ID = uuid.MustParse("9a1d7c2b-0e4f-4b6a-8c3d-2f5e7a9b1c04")
+		before := shared.NewCursor(orders, &testProductTwo)
+
+		query := "SELECT " + productColumns + " FROM products " +
+			"WHERE ((price > $1) OR (price = $1 AND created_at < $2) OR (price = $1 AND created_at = $2 AND id < $3)) " +
+			"ORDER BY price ASC, created_at DESC, id DESC LIMIT $4"
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testProductTwo.Price, testProductTwo.CreatedAt, testProductTwo.ID, 2).
+			WillReturnRows(productRows(testProductOne))
+
+		result, err := repo.ListProducts(context.Background(), shared.ProductListOptions{ListOptions: shared.ListOptions{
+			Cursor:     before,
+			Direction:  shared.PageBackward,
+			Limit:      1,
+			SortOrders: orders[:1],
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.Product{&testProductOne}, result.Products)
+		assert.True(t, result.HasMore)
+		assert.Equal(t, shared.NewCursor(orders, &testProductOne), result.NextCursor)
+		assert.False(t, result.HasPrevious)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should translate filters into parameterized predicates", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		minPrice, maxPrice, inStock := 5.0, 20.0, true
+		query := "SELECT " + productColumns + " FROM products " +
+			"WHERE category_id = $1 AND price >= $2 AND price <= $3 AND quantity >= $4 " +
+			"ORDER BY created_at ASC, id ASC LIMIT $5"
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testProductOne.CategoryID, minPrice, maxPrice, shared.MinInStockQuantity, shared.DefaultListLimit+1).
+			WillReturnRows(productRows(testProductOne))
+
+		result, err := repo.ListProducts(context.Background(), shared.ProductListOptions{
+			Filter: shared.ProductFilter{
+				CategoryID: &testProductOne.CategoryID,
+				MinPrice:   &minPrice,
+				MaxPrice:   &maxPrice,
+				InStock:    &inStock,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.Product{&testProductOne}, result.Products)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should rank full-text matches by relevance", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		maxPrice := 20.0
+		tsquery := "plainto_tsquery('simple', $2)"
+		query := "SELECT " + productColumns + ", ts_rank(search_vector, " + tsquery + ") FROM products " +
+			"WHERE price <= $1 AND search_vector @@ " + tsquery + " " +
+			"ORDER BY ts_rank(search_vector, " + tsquery + ") DESC, created_at ASC, id ASC LIMIT $3"
+		p := testProductOne
+		rows := sqlmock.NewRows([]string{
+			"id", "name", "description", "image_url", "category_id",
+			"price", "quantity", "created_at", "updated_at", "rank",
+		}).AddRow(
+			p.ID, p.Name, p.Description, p.ImageURL, p.CategoryID,
+			p.Price, p.Quantity, p.CreatedAt, p.UpdatedAt, 0.6,
+		)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(maxPrice, "chair", 2).
+			WillReturnRows(rows)
+
+		result, err := repo.ListProducts(context.Background(), shared.ProductListOptions{
+			ListOptions: shared.ListOptions{Query: "chair", Limit: 1},
+			Filter:      shared.ProductFilter{MaxPrice: &maxPrice},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.Product{&testProductOne}, result.Products)
+		assert.False(t, result.HasMore)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should reject unsupported sort direction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		_, err := repo.ListProducts(context.Background(), shared.ProductListOptions{ListOptions: shared.ListOptions{
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldPrice, Direction: "sideways"}},
+		}})
+		assert.ErrorIs(t, err, shared.ErrValidation)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductRepositoryWrites(t *testing.T) {
+	t.Run("should return conflict if category does not exist", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO products (" + productColumns + ")")).
+			WillReturnError(&pq.Error{Code: pgForeignKeyViolation, Message: "violates foreign key"})
+
+		product := testProductOne
+		err := repo.CreateProduct(context.Background(), &product)
+		assert.ErrorIs(t, err, shared.ErrConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when updating missing product", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta("UPDATE products SET")).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		product := testProductOne
+		err := repo.UpdateProduct(context.Background(), &product)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should delete product", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		repo := NewProductRepository(db)
+
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM products WHERE id = $1")).
+			WithArgs(testProductOne.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.DeleteProduct(context.Background(), testProductOne.ID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
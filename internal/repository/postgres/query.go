@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// listQuery incrementally builds a parameterized SELECT statement for the
+// list endpoints. Values are always passed as arguments; only whitelisted
+// column names are interpolated into the statement.
+type listQuery struct {
+	columns    string
+	table      string
+	conditions []string
+	orderBy    []string
+	args       []any
+}
+
+func newListQuery(columns, table string) *listQuery {
+	return &listQuery{columns: columns, table: table}
+}
+
+// arg registers a query argument and returns its placeholder.
+func (q *listQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *listQuery) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+// search restricts the query to rows whose search_vector matches every term
+// of query and selects their rank as an extra, last column. It returns
+// columns extended with shared.SortFieldRelevance resolving to the rank.
+func (q *listQuery) search(query string, columns map[string]string) map[string]string {
+	tsquery := "plainto_tsquery('simple', " + q.arg(query) + ")"
+	rank := "ts_rank(search_vector, " + tsquery + ")"
+	q.where("search_vector @@ " + tsquery)
+	q.columns += ", " + rank
+
+	extended := maps.Clone(columns)
+	extended[shared.SortFieldRelevance] = rank
+	return extended
+}
+
+// keyset orders the query by the resolved keyset orders followed by id and,
+// when cursor is set, restricts it to rows positioned after the cursor. When
+// backward is set the scan order is reversed, which selects the rows before
+// the cursor; callers must reverse the returned rows. The predicate expands
+// the row comparison so that mixed sort directions work:
+//
+//	(a > $1) OR (a = $1 AND b < $2) OR (a = $1 AND b = $2 AND id > $3)
+func (q *listQuery) keyset(
+	orders []shared.SortOrder,
+	columns map[string]string,
+	cursor *shared.Cursor,
+	backward bool,
+) error {
+	descending := make([]bool, 0, len(orders)+1)
+	resolved := make([]string, 0, len(orders))
+	for _, order := range orders {
+		column, ok := columns[order.Field]
+		if !ok {
+			return shared.Errorf(shared.KindValidation, "unsupported sort field `%s`", order.Field)
+		}
+		resolved = append(resolved, column)
+		descending = append(descending, (order.Direction == shared.SortDesc) != backward)
+	}
+	descending = append(descending, backward)
+
+	for i, column := range append(resolved, "id") {
+		q.orderBy = append(q.orderBy, column+" "+sqlDirection(descending[i]))
+	}
+
+	if cursor == nil {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(cursor.Values))
+	for _, value := range cursor.Values {
+		placeholders = append(placeholders, q.arg(value))
+	}
+	idPlaceholder := q.arg(cursor.ID)
+
+	disjuncts := make([]string, 0, len(resolved)+1)
+	for i := 0; i <= len(resolved); i++ {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, resolved[j]+" = "+placeholders[j])
+		}
+		operator := " > "
+		if descending[i] {
+			operator = " < "
+		}
+		if i < len(resolved) {
+			terms = append(terms, resolved[i]+operator+placeholders[i])
+		} else {
+			terms = append(terms, "id"+operator+idPlaceholder)
+		}
+		disjuncts = append(disjuncts, "("+strings.Join(terms, " AND ")+")")
+	}
+	q.where("(" + strings.Join(disjuncts, " OR ") + ")")
+	return nil
+}
+
+func (q *listQuery) build(limit int) string {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(q.columns)
+	sb.WriteString(" FROM ")
+	sb.WriteString(q.table)
+	if len(q.conditions) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(q.conditions, " AND "))
+	}
+	if len(q.orderBy) > 0 {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(strings.Join(q.orderBy, ", "))
+	}
+	sb.WriteString(" LIMIT ")
+	sb.WriteString(q.arg(limit))
+	return sb.String()
+}
+
+func sqlDirection(descending bool) string {
+	if descending {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// checkRowsAffected reports shared.ErrNotFound when a statement matched no rows.
+func checkRowsAffected(res sql.Result, resource string, id uuid.UUID) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows for %s `%s`, error: %w", resource, id, err)
+	}
+	if n == 0 {
+		return shared.Errorf(shared.KindNotFound, "%s `%s` does not exist", resource, id)
+	}
+	return nil
+}
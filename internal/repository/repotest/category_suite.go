@@ -0,0 +1,405 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunCategoryRepositorySuite verifies a CategoryRepository implementation.
+func RunCategoryRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("should create, get, update and delete a category", func(t *testing.T) {
+		repo := factory(t).Categories
+
+		category := newCategory("books", 0)
+		require.NoError(t, repo.CreateCategory(ctx, category))
+
+		stored, err := repo.GetCategoryByID(ctx, category.ID)
+		require.NoError(t, err)
+		assertCategoryEqual(t, category, stored)
+
+		update := &models.Category{
+			ID:          category.ID,
+			Name:        "novels",
+			Description: "",
+			TimeStamps:  models.TimeStamps{UpdatedAt: baseTime.Add(time.Hour)},
+		}
+		require.NoError(t, repo.UpdateCategory(ctx, update))
+		assert.True(t, category.CreatedAt.Equal(update.CreatedAt), "update should fill created_at")
+
+		stored, err = repo.GetCategoryByID(ctx, category.ID)
+		require.NoError(t, err)
+		assertCategoryEqual(t, update, stored)
+
+		require.NoError(t, repo.DeleteCategory(ctx, category.ID))
+		_, err = repo.GetCategoryByID(ctx, category.ID)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+	})
+
+	t.Run("should return not found for unknown categories", func(t *testing.T) {
+		repo := factory(t).Categories
+		id := uuid.New()
+
+		category, err := repo.GetCategoryByID(ctx, id)
+		assert.Nil(t, category)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+
+		missing := newCategory("missing", 0)
+		missing.ID = id
+		assert.ErrorIs(t, repo.UpdateCategory(ctx, missing), shared.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteCategory(ctx, id), shared.ErrNotFound)
+	})
+
+	t.Run("should return conflict for duplicate ids and names", func(t *testing.T) {
+		repo := factory(t).Categories
+
+		category := newCategory("books", 0)
+		require.NoError(t, repo.CreateCategory(ctx, category))
+
+		sameID := newCategory("games", 0)
+		sameID.ID = category.ID
+		assert.ErrorIs(t, repo.CreateCategory(ctx, sameID), shared.ErrConflict)
+		assert.ErrorIs(t, repo.CreateCategory(ctx, newCategory("books", time.Hour)), shared.ErrConflict)
+
+		other := newCategory("games", time.Hour)
+		require.NoError(t, repo.CreateCategory(ctx, other))
+		other.Name = "books"
+		assert.ErrorIs(t, repo.UpdateCategory(ctx, other), shared.ErrConflict)
+	})
+
+	t.Run("should return an empty page when there are no categories", func(t *testing.T) {
+		repo := factory(t).Categories
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{Limit: 10})
+		require.NoError(t, err)
+		assert.Empty(t, result.Categories)
+		assert.False(t, result.HasMore)
+	})
+
+	t.Run("should page through categories with the cursor", func(t *testing.T) {
+		repo := factory(t).Categories
+		seeded := seedCategories(t, repo, 5)
+
+		var pages [][]string
+		listOptions := shared.ListOptions{Limit: 2}
+		for {
+			result, err := repo.ListCategories(ctx, listOptions)
+			require.NoError(t, err)
+			pages = append(pages, categoryNames(result.Categories))
+			if !result.HasMore {
+				break
+			}
+			require.Less(t, len(pages), len(seeded), "pagination did not terminate")
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, [][]string{
+			{"category-000", "category-001"},
+			{"category-002", "category-003"},
+			{"category-004"},
+		}, pages)
+	})
+
+	t.Run("should page through categories sharing the same created_at", func(t *testing.T) {
+		repo := factory(t).Categories
+		expected := make(map[uuid.UUID]bool)
+		for i := range 7 {
+			category := newCategory(fmt.Sprintf("tied-%03d", i), 0)
+			require.NoError(t, repo.CreateCategory(ctx, category))
+			expected[category.ID] = true
+		}
+
+		seen := make(map[uuid.UUID]bool)
+		listOptions := shared.ListOptions{Limit: 3}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, len(expected), "pagination did not terminate")
+
+			result, err := repo.ListCategories(ctx, listOptions)
+			require.NoError(t, err)
+			for _, category := range result.Categories {
+				assert.False(t, seen[category.ID], "category %s returned twice", category.ID)
+				seen[category.ID] = true
+			}
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, expected, seen)
+	})
+
+	t.Run("should page through categories sorted by name descending", func(t *testing.T) {
+		repo := factory(t).Categories
+		seedCategories(t, repo, 5)
+
+		var names []string
+		listOptions := shared.ListOptions{
+			Limit:      2,
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldName, Direction: shared.SortDesc}},
+		}
+		for pages := 0; pages < 5; pages++ {
+			result, err := repo.ListCategories(ctx, listOptions)
+			require.NoError(t, err)
+			names = append(names, categoryNames(result.Categories)...)
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, []string{
+			"category-004", "category-003", "category-002", "category-001", "category-000",
+		}, names)
+	})
+
+	t.Run("should page backward with the previous cursor", func(t *testing.T) {
+		repo := factory(t).Categories
+		seedCategories(t, repo, 5)
+
+		last, err := repo.ListCategories(ctx, shared.ListOptions{Limit: 2, Direction: shared.PageBackward})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"category-003", "category-004"}, categoryNames(last.Categories))
+		assert.True(t, last.HasPrevious)
+		assert.False(t, last.HasMore)
+		assert.Nil(t, last.NextCursor)
+
+		middle, err := repo.ListCategories(ctx, shared.ListOptions{
+			Cursor:    last.PrevCursor,
+			Direction: shared.PageBackward,
+			Limit:     2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"category-001", "category-002"}, categoryNames(middle.Categories))
+		assert.True(t, middle.HasPrevious)
+		assert.True(t, middle.HasMore)
+
+		first, err := repo.ListCategories(ctx, shared.ListOptions{
+			Cursor:    middle.PrevCursor,
+			Direction: shared.PageBackward,
+			Limit:     2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"category-000"}, categoryNames(first.Categories))
+		assert.False(t, first.HasPrevious)
+		assert.Nil(t, first.PrevCursor)
+		assert.True(t, first.HasMore)
+
+		next, err := repo.ListCategories(ctx, shared.ListOptions{Cursor: first.NextCursor, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"category-001", "category-002"}, categoryNames(next.Categories))
+		assert.True(t, next.HasPrevious)
+	})
+
+	t.Run("should search names and descriptions ranked by relevance", func(t *testing.T) {
+		repo := factory(t).Categories
+		for i, c := range []struct{ name, description string }{
+			{"Garden Tools", "Rakes and shovels"},
+			{"Kitchen", "Pots, pans and garden herbs"},
+			{"Garden Furniture", "Outdoor chairs"},
+			{"Books", "Novels and comics"},
+		} {
+			category := newCategory(c.name, time.Duration(i)*time.Minute)
+			category.Description = c.description
+			require.NoError(t, repo.CreateCategory(ctx, category))
+		}
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{Query: "GARDEN"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Garden Tools", "Garden Furniture", "Kitchen"}, categoryNames(result.Categories))
+
+		result, err = repo.ListCategories(ctx, shared.ListOptions{Query: "garden chairs"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Garden Furniture"}, categoryNames(result.Categories))
+
+		result, err = repo.ListCategories(ctx, shared.ListOptions{
+			Query:      "garden",
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldName, Direction: shared.SortAsc}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Garden Furniture", "Garden Tools", "Kitchen"}, categoryNames(result.Categories))
+
+		result, err = repo.ListCategories(ctx, shared.ListOptions{Query: "gardening"})
+		require.NoError(t, err)
+		assert.Empty(t, result.Categories)
+	})
+
+	t.Run("should page through search results", func(t *testing.T) {
+		repo := factory(t).Categories
+		for i := range 7 {
+			category := newCategory(fmt.Sprintf("item %03d", i), time.Duration(i)*time.Minute)
+			category.Description = "plain"
+			if i%2 == 0 {
+				category.Description = "item kit"
+			}
+			require.NoError(t, repo.CreateCategory(ctx, category))
+		}
+
+		var names []string
+		listOptions := shared.ListOptions{Query: "item", Limit: 3}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 7, "pagination did not terminate")
+
+			result, err := repo.ListCategories(ctx, listOptions)
+			require.NoError(t, err)
+			names = append(names, categoryNames(result.Categories)...)
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		// Categories mentioning the term twice rank first; ties keep creation order.
+		assert.Equal(t, []string{
+			"item 000", "item 002", "item 004", "item 006", "item 001", "item 003", "item 005",
+		}, names)
+	})
+
+	t.Run("should keep the search index in sync with writes", func(t *testing.T) {
+		repo := factory(t).Categories
+		seeded := seedCategories(t, repo, 2)
+
+		updated := *seeded[0]
+		updated.Name = "Renamed"
+		updated.Description = "Freshly renamed"
+		require.NoError(t, repo.UpdateCategory(ctx, &updated))
+		require.NoError(t, repo.DeleteCategory(ctx, seeded[1].ID))
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{Query: "renamed"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Renamed"}, categoryNames(result.Categories))
+
+		result, err = repo.ListCategories(ctx, shared.ListOptions{Query: "description"})
+		require.NoError(t, err)
+		assert.Empty(t, result.Categories)
+	})
+
+	t.Run("should reject cursors produced for a different sort order", func(t *testing.T) {
+		repo := factory(t).Categories
+		seeded := seedCategories(t, repo, 2)
+
+		_, err := repo.ListCategories(ctx, shared.ListOptions{
+			Cursor:     shared.NewCursor(defaultOrders, seeded[0]),
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldName, Direction: shared.SortAsc}},
+		})
+		assert.ErrorIs(t, err, shared.ErrValidation)
+	})
+
+	t.Run("should not report more pages when the limit matches the total", func(t *testing.T) {
+		repo := factory(t).Categories
+		seeded := seedCategories(t, repo, 3)
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{Limit: 3})
+		require.NoError(t, err)
+		assert.Len(t, result.Categories, 3)
+		assert.False(t, result.HasMore)
+
+		result, err = repo.ListCategories(ctx, shared.ListOptions{
+			Cursor: shared.NewCursor(defaultOrders, seeded[2]),
+			Limit:  3,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Categories)
+		assert.False(t, result.HasMore)
+	})
+
+	t.Run("should clamp out of range limits", func(t *testing.T) {
+		repo := factory(t).Categories
+		seedCategories(t, repo, shared.MaxListLimit+5)
+
+		for _, tc := range []struct {
+			limit    int
+			expected int
+		}{
+			{limit: 0, expected: shared.DefaultListLimit},
+			{limit: -5, expected: shared.DefaultListLimit},
+			{limit: shared.MaxListLimit + 1, expected: shared.MaxListLimit},
+		} {
+			result, err := repo.ListCategories(ctx, shared.ListOptions{Limit: tc.limit})
+			require.NoError(t, err)
+			assert.Len(t, result.Categories, tc.expected, "limit %d", tc.limit)
+			assert.True(t, result.HasMore, "limit %d", tc.limit)
+		}
+	})
+
+	t.Run("should sort by whitelisted fields", func(t *testing.T) {
+		repo := factory(t).Categories
+		seedCategories(t, repo, 3)
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{
+			SortOrders: []shared.SortOrder{{Field: shared.SortFieldName, Direction: shared.SortDesc}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"category-002", "category-001", "category-000"}, categoryNames(result.Categories))
+	})
+
+	t.Run("should reject sort orders outside the whitelist", func(t *testing.T) {
+		repo := factory(t).Categories
+
+		for _, sortOrder := range []shared.SortOrder{
+			{Field: shared.SortFieldPrice, Direction: shared.SortAsc},
+			{Field: "name; DROP TABLE categories", Direction: shared.SortAsc},
+			{Field: shared.SortFieldName, Direction: "sideways"},
+		} {
+			_, err := repo.ListCategories(ctx, shared.ListOptions{SortOrders: []shared.SortOrder{sortOrder}})
+			assert.ErrorIs(t, err, shared.ErrValidation, "sort order %+v", sortOrder)
+		}
+	})
+
+	t.Run("should handle concurrent writes", func(t *testing.T) {
+		repo := factory(t).Categories
+		const writers = 20
+
+		var wg sync.WaitGroup
+		for i := range writers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				category := newCategory(fmt.Sprintf("category-%03d", i), time.Duration(i)*time.Minute)
+				assert.NoError(t, repo.CreateCategory(ctx, category))
+			}()
+		}
+		wg.Wait()
+
+		result, err := repo.ListCategories(ctx, shared.ListOptions{Limit: shared.MaxListLimit})
+		require.NoError(t, err)
+		assert.Len(t, result.Categories, writers)
+
+		var conflicts, created int
+		var mu sync.Mutex
+		for i := range writers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := repo.CreateCategory(ctx, newCategory("contended", time.Duration(i)*time.Second))
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil:
+					created++
+				case errors.Is(err, shared.ErrConflict):
+					conflicts++
+				default:
+					assert.NoError(t, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, created)
+		assert.Equal(t, writers-1, conflicts)
+	})
+}
@@ -0,0 +1,388 @@
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunProductRepositorySuite verifies a ProductRepository implementation,
+// including its referential integrity with the CategoryRepository.
+func RunProductRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+	ctx := context.Background()
+
+	// setup returns fresh repositories with a single seeded category.
+	setup := func(t *testing.T) (Repositories, *models.Category) {
+		t.Helper()
+		repos := factory(t)
+		category := newCategory("books", 0)
+		require.NoError(t, repos.Categories.CreateCategory(ctx, category))
+		return repos, category
+	}
+
+	t.Run("should create, get, update and delete a product", func(t *testing.T) {
+		repos, category := setup(t)
+		other := newCategory("games", time.Hour)
+		require.NoError(t, repos.Categories.CreateCategory(ctx, other))
+
+		product := newProduct("gopher", category.ID, 12.5, 0)
+		require.NoError(t, repos.Products.CreateProduct(ctx, product))
+
+		stored, err := repos.Products.GetProductByID(ctx, product.ID)
+		require.NoError(t, err)
+		assertProductEqual(t, product, stored)
+
+		update := &models.Product{
+			ID:         product.ID,
+			Name:       "gopher plush",
+			CategoryID: other.ID,
+			Price:      15.25,
+			Quantity:   0,
+			TimeStamps: models.TimeStamps{UpdatedAt: baseTime.Add(time.Hour)},
+		}
+		require.NoError(t, repos.Products.UpdateProduct(ctx, update))
+		assert.True(t, product.CreatedAt.Equal(update.CreatedAt), "update should fill created_at")
+
+		stored, err = repos.Products.GetProductByID(ctx, product.ID)
+		require.NoError(t, err)
+		assertProductEqual(t, update, stored)
+
+		require.NoError(t, repos.Products.DeleteProduct(ctx, product.ID))
+		_, err = repos.Products.GetProductByID(ctx, product.ID)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+	})
+
+	t.Run("should return not found for unknown products", func(t *testing.T) {
+		repos, category := setup(t)
+		id := uuid.New()
+
+		product, err := repos.Products.GetProductByID(ctx, id)
+		assert.Nil(t, product)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+
+		missing := newProduct("missing", category.ID, 1, 0)
+		missing.ID = id
+		assert.ErrorIs(t, repos.Products.UpdateProduct(ctx, missing), shared.ErrNotFound)
+		assert.ErrorIs(t, repos.Products.DeleteProduct(ctx, id), shared.ErrNotFound)
+	})
+
+	t.Run("should enforce category references", func(t *testing.T) {
+		repos, category := setup(t)
+
+		orphan := newProduct("orphan", uuid.New(), 1, 0)
+		assert.ErrorIs(t, repos.Products.CreateProduct(ctx, orphan), shared.ErrConflict)
+
+		product := newProduct("gopher", category.ID, 1, 0)
+		require.NoError(t, repos.Products.CreateProduct(ctx, product))
+
+		product.CategoryID = uuid.New()
+		assert.ErrorIs(t, repos.Products.UpdateProduct(ctx, product), shared.ErrConflict)
+		assert.ErrorIs(t, repos.Categories.DeleteCategory(ctx, category.ID), shared.ErrConflict)
+
+		require.NoError(t, repos.Products.DeleteProduct(ctx, product.ID))
+		assert.NoError(t, repos.Categories.DeleteCategory(ctx, category.ID))
+	})
+
+	t.Run("should return validation errors for negative price or quantity", func(t *testing.T) {
+		repos, category := setup(t)
+
+		negativePrice := newProduct("gopher", category.ID, -5, 0)
+		assert.ErrorIs(t, repos.Products.CreateProduct(ctx, negativePrice), shared.ErrValidation)
+		negativeQuantity := newProduct("gopher", category.ID, 5, 0)
+		negativeQuantity.Quantity = -1
+		assert.ErrorIs(t, repos.Products.CreateProduct(ctx, negativeQuantity), shared.ErrValidation)
+
+		product := newProduct("gopher", category.ID, 5, 0)
+		require.NoError(t, repos.Products.CreateProduct(ctx, product))
+		update := *product
+		update.Price = -1
+		assert.ErrorIs(t, repos.Products.UpdateProduct(ctx, &update), shared.ErrValidation)
+		update = *product
+		update.Quantity = -1
+		assert.ErrorIs(t, repos.Products.UpdateProduct(ctx, &update), shared.ErrValidation)
+
+		stored, err := repos.Products.GetProductByID(ctx, product.ID)
+		require.NoError(t, err)
+		assertProductEqual(t, product, stored)
+	})
+
+	t.Run("should return conflict for duplicate ids", func(t *testing.T) {
+		repos, category := setup(t)
+
+		product := newProduct("gopher", category.ID, 1, 0)
+		require.NoError(t, repos.Products.CreateProduct(ctx, product))
+		assert.ErrorIs(t, repos.Products.CreateProduct(ctx, product), shared.ErrConflict)
+	})
+
+	t.Run("should page through products with the cursor", func(t *testing.T) {
+		repos, category := setup(t)
+		seeded := seedProducts(t, repos.Products, category.ID, 5)
+
+		var names []string
+		listOptions := shared.ListOptions{Limit: 2}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, len(seeded), "pagination did not terminate")
+
+			result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: listOptions})
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(result.Products), 2)
+			names = append(names, productNames(result.Products)...)
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, productNames(seeded), names)
+	})
+
+	t.Run("should clamp out of range limits", func(t *testing.T) {
+		repos, category := setup(t)
+		seedProducts(t, repos.Products, category.ID, shared.DefaultListLimit+1)
+
+		result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{Limit: 0}})
+		require.NoError(t, err)
+		assert.Len(t, result.Products, shared.DefaultListLimit)
+		assert.True(t, result.HasMore)
+	})
+
+	t.Run("should sort by multiple whitelisted fields", func(t *testing.T) {
+		repos, category := setup(t)
+		for i, p := range []struct {
+			name  string
+			price float64
+		}{
+			{"alpha", 10}, {"bravo", 20}, {"charlie", 10}, {"delta", 5},
+		} {
+			product := newProduct(p.name, category.ID, p.price, time.Duration(i)*time.Minute)
+			require.NoError(t, repos.Products.CreateProduct(ctx, product))
+		}
+
+		result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{
+			SortOrders: []shared.SortOrder{
+				{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+				{Field: shared.SortFieldName, Direction: shared.SortDesc},
+			},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bravo", "charlie", "alpha", "delta"}, productNames(result.Products))
+	})
+
+	t.Run("should page through products sorted by multiple fields", func(t *testing.T) {
+		repos, category := setup(t)
+		seedProducts(t, repos.Products, category.ID, 15)
+		sortOrders := []shared.SortOrder{
+			{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+			{Field: shared.SortFieldQuantity, Direction: shared.SortAsc},
+		}
+
+		all, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{SortOrders: sortOrders}})
+		require.NoError(t, err)
+
+		var names []string
+		listOptions := shared.ListOptions{Limit: 4, SortOrders: sortOrders}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 15, "pagination did not terminate")
+
+			result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: listOptions})
+			require.NoError(t, err)
+			names = append(names, productNames(result.Products)...)
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, productNames(all.Products), names)
+	})
+
+	t.Run("should page backward through products sorted by multiple fields", func(t *testing.T) {
+		repos, category := setup(t)
+		seedProducts(t, repos.Products, category.ID, 15)
+		sortOrders := []shared.SortOrder{
+			{Field: shared.SortFieldPrice, Direction: shared.SortDesc},
+			{Field: shared.SortFieldName, Direction: shared.SortAsc},
+		}
+
+		all, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{SortOrders: sortOrders}})
+		require.NoError(t, err)
+
+		var names []string
+		listOptions := shared.ListOptions{Direction: shared.PageBackward, Limit: 4, SortOrders: sortOrders}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 15, "pagination did not terminate")
+
+			result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: listOptions})
+			require.NoError(t, err)
+			names = append(productNames(result.Products), names...)
+			if !result.HasPrevious {
+				break
+			}
+			listOptions.Cursor = result.PrevCursor
+		}
+
+		assert.Equal(t, productNames(all.Products), names)
+	})
+
+	t.Run("should filter by category, price range and stock", func(t *testing.T) {
+		repos, category := setup(t)
+		other := newCategory("other", time.Hour)
+		require.NoError(t, repos.Categories.CreateCategory(ctx, other))
+
+		for i, p := range []struct {
+			name       string
+			categoryID uuid.UUID
+			price      float64
+			quantity   int
+		}{
+			{"alpha", category.ID, 5, 3},
+			{"bravo", category.ID, 10, 0},
+			{"charlie", category.ID, 15, 1},
+			{"delta", other.ID, 10, 2},
+			{"echo", category.ID, 25, 4},
+		} {
+			product := newProduct(p.name, p.categoryID, p.price, time.Duration(i)*time.Minute)
+			product.Quantity = p.quantity
+			require.NoError(t, repos.Products.CreateProduct(ctx, product))
+		}
+
+		minPrice, maxPrice, inStock, outOfStock := 10.0, 15.0, true, false
+		for _, tc := range []struct {
+			filter   shared.ProductFilter
+			expected []string
+		}{
+			{shared.ProductFilter{}, []string{"alpha", "bravo", "charlie", "delta", "echo"}},
+			{shared.ProductFilter{CategoryID: &other.ID}, []string{"delta"}},
+			{shared.ProductFilter{MinPrice: &minPrice}, []string{"bravo", "charlie", "delta", "echo"}},
+			{shared.ProductFilter{MaxPrice: &maxPrice}, []string{"alpha", "bravo", "charlie", "delta"}},
+			{shared.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice}, []string{"bravo", "charlie", "delta"}},
+			{shared.ProductFilter{InStock: &outOfStock}, []string{"bravo"}},
+			{
+				shared.ProductFilter{CategoryID: &category.ID, MinPrice: &minPrice, InStock: &inStock},
+				[]string{"charlie", "echo"},
+			},
+		} {
+			result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{Filter: tc.filter})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, productNames(result.Products), "filter %+v", tc.filter)
+		}
+	})
+
+	t.Run("should page through filtered products", func(t *testing.T) {
+		repos, category := setup(t)
+		seeded := seedProducts(t, repos.Products, category.ID, 14)
+		maxPrice := 3.0
+
+		var expected []string
+		for _, product := range seeded {
+			if product.Price <= maxPrice {
+				expected = append(expected, product.Name)
+			}
+		}
+
+		var names []string
+		listOptions := shared.ProductListOptions{
+			ListOptions: shared.ListOptions{Limit: 2},
+			Filter:      shared.ProductFilter{MaxPrice: &maxPrice},
+		}
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, len(seeded), "pagination did not terminate")
+
+			result, err := repos.Products.ListProducts(ctx, listOptions)
+			require.NoError(t, err)
+			names = append(names, productNames(result.Products)...)
+			if !result.HasMore {
+				break
+			}
+			listOptions.Cursor = result.NextCursor
+		}
+
+		assert.Equal(t, expected, names)
+	})
+
+	t.Run("should combine search with filters", func(t *testing.T) {
+		repos, category := setup(t)
+		for i, p := range []struct {
+			name        string
+			description string
+			price       float64
+		}{
+			{"Red Chair", "Wooden chair", 40},
+			{"Blue Chair", "Plastic chair", 15},
+			{"Red Table", "Wooden table", 90},
+		} {
+			product := newProduct(p.name, category.ID, p.price, time.Duration(i)*time.Minute)
+			product.Description = p.description
+			require.NoError(t, repos.Products.CreateProduct(ctx, product))
+		}
+
+		result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{
+			ListOptions: shared.ListOptions{Query: "chair"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"Red Chair", "Blue Chair"}, productNames(result.Products))
+
+		maxPrice := 50.0
+		result, err = repos.Products.ListProducts(ctx, shared.ProductListOptions{
+			ListOptions: shared.ListOptions{Query: "wooden"},
+			Filter:      shared.ProductFilter{MaxPrice: &maxPrice},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Red Chair"}, productNames(result.Products))
+	})
+
+	t.Run("should reject sort orders outside the whitelist", func(t *testing.T) {
+		repos, _ := setup(t)
+
+		for _, sortOrder := range []shared.SortOrder{
+			{Field: "description", Direction: shared.SortAsc},
+			{Field: "price DESC; --", Direction: shared.SortAsc},
+			{Field: shared.SortFieldPrice, Direction: "DESC"},
+		} {
+			_, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{SortOrders: []shared.SortOrder{sortOrder}}})
+			assert.ErrorIs(t, err, shared.ErrValidation, "sort order %+v", sortOrder)
+		}
+	})
+
+	t.Run("should handle concurrent writes", func(t *testing.T) {
+		repos, category := setup(t)
+		seeded := seedProducts(t, repos.Products, category.ID, 10)
+		const writers = 20
+
+		var wg sync.WaitGroup
+		for i := range writers {
+			wg.Add(3)
+			go func() {
+				defer wg.Done()
+				product := newProduct(fmt.Sprintf("new-%03d", i), category.ID, 1, time.Duration(i)*time.Second)
+				assert.NoError(t, repos.Products.CreateProduct(ctx, product))
+			}()
+			go func() {
+				defer wg.Done()
+				product := *seeded[i%len(seeded)]
+				product.Quantity = i
+				assert.NoError(t, repos.Products.UpdateProduct(ctx, &product))
+			}()
+			go func() {
+				defer wg.Done()
+				_, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{Limit: 5}})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		result, err := repos.Products.ListProducts(ctx, shared.ProductListOptions{ListOptions: shared.ListOptions{Limit: shared.MaxListLimit}})
+		require.NoError(t, err)
+		assert.Len(t, result.Products, len(seeded)+writers)
+	})
+}
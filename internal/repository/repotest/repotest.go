@@ -0,0 +1,130 @@
+// Package repotest provides conformance suites that every CategoryRepository
+// and ProductRepository implementation must pass, so that all storage
+// backends are verified against identical behavior.
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Repositories groups the repositories under test. Both must share the same
+// underlying storage so referential integrity can be verified.
+type Repositories struct {
+	Categories interfaces.CategoryRepository
+	Products   interfaces.ProductRepository
+}
+
+// Factory returns repositories backed by fresh, empty storage. It is called
+// once per subtest.
+type Factory func(t *testing.T) Repositories
+
+// baseTime is truncated to microseconds, the precision of PostgreSQL timestamps.
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// defaultOrders are the keyset orders used when no sort order is requested.
+var defaultOrders = []shared.SortOrder{{Field: shared.SortFieldCreatedAt, Direction: shared.SortAsc}}
+
+func newCategory(name string, offset time.Duration) *models.Category {
+	createdAt := baseTime.Add(offset)
+	return &models.Category{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: name + " description",
+		TimeStamps: models.TimeStamps{
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+	}
+}
+
+func newProduct(name string, categoryID uuid.UUID, price float64, offset time.Duration) *models.Product {
+	createdAt := baseTime.Add(offset)
+	return &models.Product{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: name + " description",
+		ImageURL:    "https://example.com/" + name + ".png",
+		CategoryID:  categoryID,
+		Price:       price,
+		Quantity:    1,
+		TimeStamps: models.TimeStamps{
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+	}
+}
+
+// seedCategories creates n categories named `category-NNN`, one minute apart.
+func seedCategories(t *testing.T, repo interfaces.CategoryRepository, n int) []*models.Category {
+	t.Helper()
+	categories := make([]*models.Category, 0, n)
+	for i := range n {
+		category := newCategory(fmt.Sprintf("category-%03d", i), time.Duration(i)*time.Minute)
+		require.NoError(t, repo.CreateCategory(context.Background(), category))
+		categories = append(categories, category)
+	}
+	return categories
+}
+
+// seedProducts creates n products named `product-NNN`, one minute apart.
+func seedProducts(t *testing.T, repo interfaces.ProductRepository, categoryID uuid.UUID, n int) []*models.Product {
+	t.Helper()
+	products := make([]*models.Product, 0, n)
+	for i := range n {
+		product := newProduct(fmt.Sprintf("product-%03d", i), categoryID, float64(i%7)+0.5, time.Duration(i)*time.Minute)
+		require.NoError(t, repo.CreateProduct(context.Background(), product))
+		products = append(products, product)
+	}
+	return products
+}
+
+func assertCategoryEqual(t *testing.T, expected, actual *models.Category) {
+	t.Helper()
+	require.NotNil(t, actual)
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt), "created_at: expected %s, got %s", expected.CreatedAt, actual.CreatedAt)
+	assert.True(t, expected.UpdatedAt.Equal(actual.UpdatedAt), "updated_at: expected %s, got %s", expected.UpdatedAt, actual.UpdatedAt)
+}
+
+func assertProductEqual(t *testing.T, expected, actual *models.Product) {
+	t.Helper()
+	require.NotNil(t, actual)
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.Equal(t, expected.ImageURL, actual.ImageURL)
+	assert.Equal(t, expected.CategoryID, actual.CategoryID)
+	assert.InDelta(t, expected.Price, actual.Price, 0.001)
+	assert.Equal(t, expected.Quantity, actual.Quantity)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt), "created_at: expected %s, got %s", expected.CreatedAt, actual.CreatedAt)
+	assert.True(t, expected.UpdatedAt.Equal(actual.UpdatedAt), "updated_at: expected %s, got %s", expected.UpdatedAt, actual.UpdatedAt)
+}
+
+func categoryNames(categories []*models.Category) []string {
+	names := make([]string, 0, len(categories))
+	for _, category := range categories {
+		names = append(names, category.Name)
+	}
+	return names
+}
+
+func productNames(products []*models.Product) []string {
+	names := make([]string, 0, len(products))
+	for _, product := range products {
+		names = append(names, product.Name)
+	}
+	return names
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"net/http"
+
+	"product-services/internal/handlers"
+)
+
+// NewRouter registers every API route and the health probes on a new
+// ServeMux. The admin routes are only registered when adminHandler is not nil
+// and `GET /metrics` only when metricsHandler is not nil.
+func NewRouter(
+	categoryHandler *handlers.CategoryHandler,
+	productHandler *handlers.ProductHandler,
+	adminHandler *handlers.AdminHandler,
+	healthHandler *handlers.HealthHandler,
+	metricsHandler http.Handler,
+) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /healthz", healthHandler.Liveness)
+	mux.HandleFunc("GET /readyz", healthHandler.Readiness)
+
+	mux.HandleFunc("GET /categories", categoryHandler.ListCategories)
+	mux.HandleFunc("POST /categories", categoryHandler.CreateCategory)
+	mux.HandleFunc("GET /categories/{id}", categoryHandler.GetCategory)
+	mux.HandleFunc("PUT /categories/{id}", categoryHandler.UpdateCategory)
+	mux.HandleFunc("DELETE /categories/{id}", categoryHandler.DeleteCategory)
+
+	mux.HandleFunc("GET /products", productHandler.ListProducts)
+	mux.HandleFunc("POST /products", productHandler.CreateProduct)
+	mux.HandleFunc("GET /products/{id}", productHandler.GetProduct)
+	mux.HandleFunc("PUT /products/{id}", productHandler.UpdateProduct)
+	mux.HandleFunc("DELETE /products/{id}", productHandler.DeleteProduct)
+
+	if adminHandler != nil {
+		mux.HandleFunc("GET /admin/log-level", adminHandler.GetLogLevel)
+		mux.HandleFunc("PUT /admin/log-level", adminHandler.SetLogLevel)
+	}
+
+	if metricsHandler != nil {
+		mux.Handle("GET /metrics", metricsHandler)
+	}
+
+	return mux
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"product-services/internal/interfaces"
+)
+
+const (
+	DefaultAddr            = ":8080"
+	DefaultReadTimeout     = 10 * time.Second
+	DefaultWriteTimeout    = 15 * time.Second
+	DefaultIdleTimeout     = 60 * time.Second
+	DefaultShutdownTimeout = 30 * time.Second
+	DefaultShutdownDelay   = time.Duration(0)
+)
+
+// Config holds the HTTP server settings.
+type Config struct {
+	Addr            string
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+	// ShutdownDelay is how long the server keeps accepting requests after
+	// the shutdown hooks ran, giving load balancers time to notice that the
+	// service is no longer ready.
+	ShutdownDelay time.Duration
+}
+
+type Server struct {
+	httpServer      *http.Server
+	shutdownTimeout time.Duration
+	shutdownDelay   time.Duration
+	onShutdown      []func()
+	logger          interfaces.AppLogger
+}
+
+func NewServer(cfg Config, handler http.Handler, logger interfaces.AppLogger) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              cfg.Addr,
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+		shutdownTimeout: cfg.ShutdownTimeout,
+		shutdownDelay:   cfg.ShutdownDelay,
+		logger:          logger,
+	}
+}
+
+// OnShutdown registers f to be called as soon as the graceful shutdown
+// starts, before the shutdown delay.
+func (s *Server) OnShutdown(f func()) {
+	s.onShutdown = append(s.onShutdown, f)
+}
+
+// Run listens on the configured address and serves requests until ctx is
+// cancelled, then shuts the server down gracefully.
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on `%s`, error: %w", s.httpServer.Addr, err)
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve accepts connections on ln until ctx is cancelled. On cancellation it
+// runs the shutdown hooks, keeps serving for the shutdown delay, then stops
+// accepting new connections and waits up to the shutdown timeout for
+// in-flight requests to complete.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		appLogger := s.logger.Logger()
+		appLogger.Info().Str("addr", ln.Addr().String()).Msg("HTTP server listening")
+		serveErr <- s.httpServer.Serve(ln)
+	}()
+
+	select {
+	case err := <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("HTTP server failed, error: %w", err)
+	case <-ctx.Done():
+	}
+
+	appLogger := s.logger.Logger()
+	appLogger.Info().Dur("shutdown_delay", s.shutdownDelay).Msg("Shutting down HTTP server")
+
+	for _, f := range s.onShutdown {
+		f()
+	}
+	time.Sleep(s.shutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("graceful shutdown failed, error: %w", err)
+	}
+
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("HTTP server failed, error: %w", err)
+	}
+
+	appLogger.Info().Msg("HTTP server stopped")
+	return nil
+}
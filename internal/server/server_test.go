@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"product-services/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	env     = "prod"
+	service = "ProductService"
+)
+
+func TestServe(t *testing.T) {
+	t.Run("should drain in-flight requests on shutdown", func(t *testing.T) {
+		started := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			close(started)
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("done"))
+		})
+
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		srv := NewServer(Config{ShutdownTimeout: 5 * time.Second}, handler, logger)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- srv.Serve(ctx, ln)
+		}()
+
+		type result struct {
+			status int
+			body   string
+			err    error
+		}
+		respCh := make(chan result, 1)
+		go func() {
+			resp, err := http.Get("http://" + ln.Addr().String())
+			if err != nil {
+				respCh <- result{err: err}
+				return
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			respCh <- result{status: resp.StatusCode, body: string(body), err: err}
+		}()
+
+		<-started
+		cancel()
+
+		res := <-respCh
+		require.NoError(t, res.err)
+		assert.Equal(t, http.StatusOK, res.status)
+		assert.Equal(t, "done", res.body)
+		assert.NoError(t, <-serveErr)
+
+		// new connections are refused once the server has stopped
+		_, err = http.Get("http://" + ln.Addr().String())
+		assert.Error(t, err)
+	})
+
+	t.Run("should run the shutdown hooks and keep serving for the shutdown delay", func(t *testing.T) {
+		var shuttingDown atomic.Bool
+		handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if shuttingDown.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+		logger := logger.NewLogger(env, service, io.Discard)
+		srv := NewServer(Config{ShutdownTimeout: 5 * time.Second, ShutdownDelay: 300 * time.Millisecond}, handler, logger)
+		hookCalled := make(chan struct{})
+		srv.OnShutdown(func() {
+			shuttingDown.Store(true)
+			close(hookCalled)
+		})
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- srv.Serve(ctx, ln)
+		}()
+
+		cancel()
+		<-hookCalled
+
+		// the server still accepts requests during the delay
+		resp, err := http.Get("http://" + ln.Addr().String())
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.NoError(t, <-serveErr)
+	})
+
+	t.Run("should return error if listener fails", func(t *testing.T) {
+		var logBuf bytes.Buffer
+		logger := logger.NewLogger(env, service, &logBuf)
+		srv := NewServer(Config{}, http.NotFoundHandler(), logger)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		ln.Close()
+
+		err = srv.Serve(context.Background(), ln)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,238 @@
+package shared
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CursorVersion is the version of the encoded cursor format. Version 0 is the
+// legacy format: a bare created_at timestamp.
+const CursorVersion = 1
+
+type sortFieldKind int
+
+const (
+	kindString sortFieldKind = iota
+	kindFloat
+	kindInt
+	kindTime
+)
+
+// sortFieldKinds records the value type of every sortable field so that
+// cursor values can be decoded back into typed values.
+var sortFieldKinds = map[string]sortFieldKind{
+	SortFieldName:      kindString,
+	SortFieldPrice:     kindFloat,
+	SortFieldQuantity:  kindInt,
+	SortFieldCreatedAt: kindTime,
+	SortFieldUpdatedAt: kindTime,
+	SortFieldRelevance: kindFloat,
+}
+
+// Sortable is implemented by models that can be listed with keyset pagination.
+type Sortable interface {
+	// SortValue returns the value of a sortable field.
+	SortValue(field string) any
+	// SortID returns the unique row ID used as the final tiebreaker.
+	SortID() uuid.UUID
+}
+
+// Cursor identifies a position in a sorted listing: the sort key values of a
+// row plus its ID as a tiebreaker for rows sharing the same key values.
+type Cursor struct {
+	// SortOrders are the keyset orders the cursor was produced for.
+	SortOrders []SortOrder
+	// Values holds one value per sort order: string, float64, int or time.Time.
+	Values []any
+	ID     uuid.UUID
+}
+
+// NewCursor builds the cursor pointing at item for the given keyset orders.
+func NewCursor(orders []SortOrder, item Sortable) *Cursor {
+	values := make([]any, 0, len(orders))
+	for _, order := range orders {
+		values = append(values, item.SortValue(order.Field))
+	}
+	return &Cursor{
+		SortOrders: slices.Clone(orders),
+		Values:     values,
+		ID:         item.SortID(),
+	}
+}
+
+// KeysetOrders validates sortOrders against the allowed fields and returns
+// the orders used for keyset pagination: the requested orders without
+// duplicates, followed by created_at ascending when it was not requested.
+// The row ID is always the final, ascending tiebreaker.
+func KeysetOrders(sortOrders []SortOrder, allowed []string) ([]SortOrder, error) {
+	orders := make([]SortOrder, 0, len(sortOrders)+1)
+	for _, sortOrder := range sortOrders {
+		if !slices.Contains(allowed, sortOrder.Field) {
+			return nil, Errorf(KindValidation, "unsupported sort field `%s`", sortOrder.Field)
+		}
+
+		direction := sortOrder.Direction
+		switch direction {
+		case SortAsc, SortDesc:
+		case "":
+			direction = SortAsc
+		default:
+			return nil, Errorf(KindValidation, "unsupported sort direction `%s`", direction)
+		}
+
+		if slices.ContainsFunc(orders, func(o SortOrder) bool { return o.Field == sortOrder.Field }) {
+			continue
+		}
+		orders = append(orders, SortOrder{Field: sortOrder.Field, Direction: direction})
+	}
+
+	if !slices.ContainsFunc(orders, func(o SortOrder) bool { return o.Field == SortFieldCreatedAt }) {
+		orders = append(orders, SortOrder{Field: SortFieldCreatedAt, Direction: SortAsc})
+	}
+	return orders, nil
+}
+
+// Validate checks that the cursor was produced for the given keyset orders.
+func (c *Cursor) Validate(orders []SortOrder) error {
+	if !slices.Equal(c.SortOrders, orders) || len(c.Values) != len(orders) {
+		return Errorf(KindValidation, "cursor does not match the requested sort order")
+	}
+	return nil
+}
+
+type cursorPayload struct {
+	Version int               `json:"v"`
+	Sort    []string          `json:"s"`
+	Values  []json.RawMessage `json:"k"`
+	ID      uuid.UUID         `json:"id"`
+}
+
+// Encode encodes the cursor into an opaque base64 URL-safe string.
+func (c *Cursor) Encode() string {
+	payload := cursorPayload{
+		Version: CursorVersion,
+		Sort:    make([]string, 0, len(c.SortOrders)),
+		Values:  make([]json.RawMessage, 0, len(c.Values)),
+		ID:      c.ID,
+	}
+	for _, order := range c.SortOrders {
+		payload.Sort = append(payload.Sort, formatSortOrder(order))
+	}
+	for _, value := range c.Values {
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC().Format(time.RFC3339Nano)
+		}
+		// Values are limited to strings, numbers and formatted times, which
+		// always marshal successfully.
+		raw, _ := json.Marshal(value)
+		payload.Values = append(payload.Values, raw)
+	}
+
+	data, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor produced by Cursor.Encode. Legacy cursors
+// holding only a created_at timestamp are accepted and positioned after every
+// row with that timestamp.
+func DecodeCursor(cursor string) (*Cursor, error) {
+	decodedBytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding: `%s`, error: %v", cursor, err)
+	}
+
+	if len(decodedBytes) == 0 || decodedBytes[0] != '{' {
+		t, err := time.Parse(time.RFC3339Nano, string(decodedBytes))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor time format: `%s`, error: %v", cursor, err)
+		}
+		return &Cursor{
+			SortOrders: []SortOrder{{Field: SortFieldCreatedAt, Direction: SortAsc}},
+			Values:     []any{t},
+			ID:         uuid.Max,
+		}, nil
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(decodedBytes, &payload); err != nil {
+		return nil, fmt.Errorf("invalid cursor payload: `%s`, error: %v", cursor, err)
+	}
+	if payload.Version != CursorVersion {
+		return nil, fmt.Errorf("unsupported cursor version: `%d`", payload.Version)
+	}
+	if len(payload.Sort) != len(payload.Values) {
+		return nil, errors.New("invalid cursor payload: sort and value counts differ")
+	}
+
+	c := &Cursor{
+		SortOrders: make([]SortOrder, 0, len(payload.Sort)),
+		Values:     make([]any, 0, len(payload.Values)),
+		ID:         payload.ID,
+	}
+	for i, s := range payload.Sort {
+		order := parseSortOrder(s)
+		value, err := decodeSortValue(order.Field, payload.Values[i])
+		if err != nil {
+			return nil, err
+		}
+		c.SortOrders = append(c.SortOrders, order)
+		c.Values = append(c.Values, value)
+	}
+	return c, nil
+}
+
+func formatSortOrder(order SortOrder) string {
+	if order.Direction == SortDesc {
+		return "-" + order.Field
+	}
+	return order.Field
+}
+
+func parseSortOrder(s string) SortOrder {
+	if field, ok := strings.CutPrefix(s, "-"); ok {
+		return SortOrder{Field: field, Direction: SortDesc}
+	}
+	return SortOrder{Field: s, Direction: SortAsc}
+}
+
+func decodeSortValue(field string, raw json.RawMessage) (any, error) {
+	kind, ok := sortFieldKinds[field]
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor sort field: `%s`", field)
+	}
+
+	var err error
+	switch kind {
+	case kindFloat:
+		var v float64
+		if err = json.Unmarshal(raw, &v); err == nil {
+			return v, nil
+		}
+	case kindInt:
+		var v int
+		if err = json.Unmarshal(raw, &v); err == nil {
+			return v, nil
+		}
+	case kindTime:
+		var s string
+		if err = json.Unmarshal(raw, &s); err == nil {
+			var t time.Time
+			if t, err = time.Parse(time.RFC3339Nano, s); err == nil {
+				return t, nil
+			}
+		}
+	default:
+		var v string
+		if err = json.Unmarshal(raw, &v); err == nil {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid cursor value for `%s`, error: %v", field, err)
+}
@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortableItem struct {
+	id     uuid.UUID
+	values map[string]any
+}
+
+func (s sortableItem) SortValue(field string) any { return s.values[field] }
+
+func (s sortableItem) SortID() uuid.UUID { return s.id }
+
+func TestCursorEncodeDecode(t *testing.T) {
+	t.Run("should round trip every sort value type", func(t *testing.T) {
+		orders := []SortOrder{
+			{Field: SortFieldPrice, Direction: SortDesc},
+			{Field: SortFieldName, Direction: SortAsc},
+			{Field: SortFieldQuantity, Direction: SortAsc},
+			{Field: SortFieldCreatedAt, Direction: SortAsc},
+		}
+		item := sortableItem{
+			id: uuid.MustParse("3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"),
+			values: map[string]any{
+				SortFieldPrice:     9.99,
+				SortFieldName:      "Test Product A",
+				SortFieldQuantity:  10,
+				SortFieldCreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 123456000, time.UTC),
+			},
+		}
+
+		cursor := NewCursor(orders, item)
+		decoded, err := DecodeCursor(cursor.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+		assert.NoError(t, decoded.Validate(orders))
+	})
+
+	t.Run("should decode legacy timestamp cursors", func(t *testing.T) {
+		decoded, err := DecodeCursor("MjAyMy0wMS0wMVQwMDowMDowMFo")
+		require.NoError(t, err)
+		assert.Equal(t, &Cursor{
+			SortOrders: []SortOrder{{Field: SortFieldCreatedAt, Direction: SortAsc}},
+			Values:     []any{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			ID:         uuid.Max,
+		}, decoded)
+	})
+
+	t.Run("should reject unsupported versions and fields", func(t *testing.T) {
+		for _, payload := range []string{
+			`{"v":2,"s":["name"],"k":["a"],"id":"3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"}`,
+			`{"v":1,"s":["description"],"k":["a"],"id":"3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"}`,
+			`{"v":1,"s":["price"],"k":["a"],"id":"3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"}`,
+			`{"v":1,"s":["name","price"],"k":["a"],"id":"3c6f0f4e-5b7a-4d5e-9a43-6f1c2d9b8e01"}`,
+		} {
+			_, err := DecodeCursor(base64.RawURLEncoding.EncodeToString([]byte(payload)))
+			assert.Error(t, err, payload)
+		}
+	})
+}
+
+func TestKeysetOrders(t *testing.T) {
+	allowed := []string{SortFieldName, SortFieldCreatedAt}
+
+	t.Run("should append created_at and drop duplicate fields", func(t *testing.T) {
+		orders, err := KeysetOrders([]SortOrder{
+			{Field: SortFieldName, Direction: SortDesc},
+			{Field: SortFieldName, Direction: SortAsc},
+		}, allowed)
+		require.NoError(t, err)
+		assert.Equal(t, []SortOrder{
+			{Field: SortFieldName, Direction: SortDesc},
+			{Field: SortFieldCreatedAt, Direction: SortAsc},
+		}, orders)
+	})
+
+	t.Run("should reject cursors produced for another ordering", func(t *testing.T) {
+		listOptions := ListOptions{
+			Cursor:     &Cursor{SortOrders: []SortOrder{{Field: SortFieldCreatedAt, Direction: SortAsc}}, Values: []any{time.Time{}}},
+			SortOrders: []SortOrder{{Field: SortFieldName, Direction: SortAsc}},
+		}
+		_, err := listOptions.Keyset(allowed)
+		assert.ErrorIs(t, err, ErrValidation)
+	})
+}
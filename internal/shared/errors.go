@@ -0,0 +1,86 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies a domain error independently of the backend that produced it.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindConflict
+	KindValidation
+	KindUnavailable
+	KindTimeout
+)
+
+var kindNames = map[Kind]string{
+	KindInternal:    "internal error",
+	KindNotFound:    "resource not found",
+	KindConflict:    "resource conflict",
+	KindValidation:  "validation failed",
+	KindUnavailable: "service unavailable",
+	KindTimeout:     "operation timed out",
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return kindNames[KindInternal]
+}
+
+// Error is the typed error returned by repository implementations. It matches
+// the sentinel of its Kind with errors.Is, so callers can either compare
+// against the sentinels or inspect the Kind with KindOf.
+type Error struct {
+	Kind    Kind
+	Message string
+	Err     error
+}
+
+// Sentinel errors, one per Kind, returned by repository implementations.
+var (
+	ErrNotFound    = &Error{Kind: KindNotFound}
+	ErrConflict    = &Error{Kind: KindConflict}
+	ErrValidation  = &Error{Kind: KindValidation}
+	ErrUnavailable = &Error{Kind: KindUnavailable}
+	ErrTimeout     = &Error{Kind: KindTimeout}
+)
+
+// Errorf returns an error of the given kind. As with fmt.Errorf, a `%w` verb
+// in format keeps the wrapped error reachable through errors.Is and errors.As.
+func Errorf(kind Kind, format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	return &Error{Kind: kind, Message: err.Error(), Err: errors.Unwrap(err)}
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return e.Kind.String()
+	}
+	return e.Kind.String() + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is an Error of the same kind.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind
+}
+
+// KindOf returns the kind of the first Error in err's chain, or KindInternal
+// when there is none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
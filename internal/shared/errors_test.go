@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	t.Run("should match the sentinel of its kind", func(t *testing.T) {
+		err := fmt.Errorf("failed to get category, error: %w", Errorf(KindNotFound, "category `%s` does not exist", "abc"))
+
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrConflict)
+		assert.Equal(t, KindNotFound, KindOf(err))
+		assert.Equal(t, "failed to get category, error: resource not found: category `abc` does not exist", err.Error())
+	})
+
+	t.Run("should keep the wrapped cause", func(t *testing.T) {
+		err := Errorf(KindUnavailable, "read failed: %w", io.ErrUnexpectedEOF)
+
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("should report untyped errors as internal", func(t *testing.T) {
+		assert.Equal(t, KindInternal, KindOf(errors.New("boom")))
+		assert.Equal(t, KindInternal, KindOf(nil))
+		assert.Equal(t, "operation timed out", ErrTimeout.Error())
+	})
+}
@@ -1,6 +1,10 @@
 package shared
 
-import "time"
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
 
 type SortDirection string
 
@@ -9,6 +13,32 @@ const (
 	SortDesc SortDirection = "desc"
 )
 
+// PageDirection selects which side of ListOptions.Cursor a listing reads.
+type PageDirection string
+
+const (
+	PageForward  PageDirection = "forward"
+	PageBackward PageDirection = "backward"
+)
+
+// Limits applied by repositories when ListOptions.Limit is out of range.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Sortable fields shared by the list queries.
+const (
+	SortFieldName      = "name"
+	SortFieldPrice     = "price"
+	SortFieldQuantity  = "quantity"
+	SortFieldCreatedAt = "created_at"
+	SortFieldUpdatedAt = "updated_at"
+	// SortFieldRelevance orders search results by their rank. It is only
+	// available when ListOptions.Query is set.
+	SortFieldRelevance = "relevance"
+)
+
 // Common types
 
 // SortOrder defines a sort rule for listing queries.
@@ -20,7 +50,84 @@ type SortOrder struct {
 
 // ListOptions defines common parameters for paginated and sorted list queries.
 type ListOptions struct {
-	CreatedAfter time.Time
-	Limit        int // should be validated to enforce min / max limits
-	SortOrders   []SortOrder
+	// Cursor, when set, restricts the listing to rows positioned after it,
+	// or before it when Direction is PageBackward.
+	Cursor *Cursor
+	// Direction defaults to PageForward. PageBackward scans the sort order in
+	// reverse, which without a Cursor yields the last page. Items are always
+	// returned in the requested sort order.
+	Direction  PageDirection
+	Limit      int // validated by the handlers; clamped to MaxListLimit as a safeguard
+	SortOrders []SortOrder
+	// Query, when set, restricts the listing to items whose name or
+	// description match every search term. Results are ordered by relevance
+	// unless SortOrders says otherwise.
+	Query string
+}
+
+// EffectiveLimit returns Limit clamped to MaxListLimit, falling back to
+// DefaultListLimit when it is not positive.
+func (o ListOptions) EffectiveLimit() int {
+	switch {
+	case o.Limit <= 0:
+		return DefaultListLimit
+	case o.Limit > MaxListLimit:
+		return MaxListLimit
+	default:
+		return o.Limit
+	}
+}
+
+// Keyset resolves SortOrders into keyset orders using the allowed fields and
+// checks that Cursor, if any, was produced for the same ordering. Searches
+// additionally allow SortFieldRelevance and default to it, descending.
+func (o ListOptions) Keyset(allowed []string) ([]SortOrder, error) {
+	sortOrders := o.SortOrders
+	if o.Query != "" {
+		allowed = append(slices.Clip(allowed), SortFieldRelevance)
+		if len(sortOrders) == 0 {
+			sortOrders = []SortOrder{{Field: SortFieldRelevance, Direction: SortDesc}}
+		}
+	}
+
+	orders, err := KeysetOrders(sortOrders, allowed)
+	if err != nil {
+		return nil, err
+	}
+	if o.Cursor != nil {
+		if err := o.Cursor.Validate(orders); err != nil {
+			return nil, err
+		}
+	}
+	return orders, nil
+}
+
+// Backward reports whether the listing reads the page before Cursor.
+func (o ListOptions) Backward() bool {
+	return o.Direction == PageBackward
+}
+
+// ProductFilter narrows product listings. Nil fields are not applied.
+type ProductFilter struct {
+	CategoryID *uuid.UUID
+	MinPrice   *float64
+	MaxPrice   *float64
+	// InStock selects products with a positive quantity when true and
+	// products with no quantity left when false.
+	InStock *bool
+}
+
+// MinInStockQuantity is the lowest quantity of a product in stock. Every
+// backend derives the InStock predicate from it.
+const MinInStockQuantity = 1
+
+// IsInStock reports whether a product with quantity matches InStock = true.
+func IsInStock(quantity int) bool {
+	return quantity >= MinInStockQuantity
+}
+
+// ProductListOptions defines the parameters of product list queries.
+type ProductListOptions struct {
+	ListOptions
+	Filter ProductFilter
 }
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// MaxSearchQueryLength bounds the length of ListOptions.Query.
+const MaxSearchQueryLength = 200
+
+// Ranked pairs a search result with its relevance so that the rank can be
+// used as a sort key and encoded in cursors.
+type Ranked[T Sortable] struct {
+	Item T
+	Rank float64
+}
+
+func (r Ranked[T]) SortValue(field string) any {
+	if field == SortFieldRelevance {
+		return r.Rank
+	}
+	return r.Item.SortValue(field)
+}
+
+func (r Ranked[T]) SortID() uuid.UUID {
+	return r.Item.SortID()
+}
+
+// Items unwraps ranked search results.
+func Items[T Sortable](ranked []Ranked[T]) []T {
+	items := make([]T, 0, len(ranked))
+	for _, r := range ranked {
+		items = append(items, r.Item)
+	}
+	return items
+}
+
+// Tokenize splits text into lowercase search terms on any character that is
+// neither a letter nor a digit, approximating PostgreSQL's `simple` text
+// search configuration.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+
+	"product-services/internal/interfaces"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startRepositorySpan starts the span of a repository call. The returned
+// function ends it, recording err when it is not nil.
+func startRepositorySpan(
+	ctx context.Context,
+	tracer trace.Tracer,
+	repository string,
+	method string,
+) (context.Context, func(err *error)) {
+	ctx, span := tracer.Start(ctx, repository+"."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("repository", repository),
+			attribute.String("repository.method", method),
+		),
+	)
+	return ctx, func(err *error) {
+		if *err != nil {
+			span.RecordError(*err)
+			span.SetAttributes(attribute.String("error.kind", shared.KindOf(*err).String()))
+			span.SetStatus(codes.Error, (*err).Error())
+		}
+		span.End()
+	}
+}
+
+type categoryRepository struct {
+	next   interfaces.CategoryRepository
+	tracer trace.Tracer
+}
+
+// InstrumentCategoryRepository returns repo creating a span, provided by
+// tp, around each of its calls.
+func InstrumentCategoryRepository(repo interfaces.CategoryRepository, tp trace.TracerProvider) interfaces.CategoryRepository {
+	return &categoryRepository{next: repo, tracer: tp.Tracer(TracerName)}
+}
+
+func (r *categoryRepository) GetCategoryByID(ctx context.Context, id uuid.UUID) (_ *models.Category, err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "CategoryRepository", "GetCategoryByID")
+	defer end(&err)
+	return r.next.GetCategoryByID(ctx, id)
+}
+
+func (r *categoryRepository) ListCategories(
+	ctx context.Context,
+	listOptions shared.ListOptions,
+) (_ *models.ListCategoriesResult, err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "CategoryRepository", "ListCategories")
+	defer end(&err)
+	return r.next.ListCategories(ctx, listOptions)
+}
+
+func (r *categoryRepository) CreateCategory(ctx context.Context, category *models.Category) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "CategoryRepository", "CreateCategory")
+	defer end(&err)
+	return r.next.CreateCategory(ctx, category)
+}
+
+func (r *categoryRepository) UpdateCategory(ctx context.Context, category *models.Category) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "CategoryRepository", "UpdateCategory")
+	defer end(&err)
+	return r.next.UpdateCategory(ctx, category)
+}
+
+func (r *categoryRepository) DeleteCategory(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "CategoryRepository", "DeleteCategory")
+	defer end(&err)
+	return r.next.DeleteCategory(ctx, id)
+}
+
+type productRepository struct {
+	next   interfaces.ProductRepository
+	tracer trace.Tracer
+}
+
+// InstrumentProductRepository returns repo creating a span, provided by tp,
+// around each of its calls.
+func InstrumentProductRepository(repo interfaces.ProductRepository, tp trace.TracerProvider) interfaces.ProductRepository {
+	return &productRepository{next: repo, tracer: tp.Tracer(TracerName)}
+}
+
+func (r *productRepository) GetProductByID(ctx context.Context, id uuid.UUID) (_ *models.Product, err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "ProductRepository", "GetProductByID")
+	defer end(&err)
+	return r.next.GetProductByID(ctx, id)
+}
+
+func (r *productRepository) ListProducts(
+	ctx context.Context,
+	listOptions shared.ProductListOptions,
+) (_ *models.ListProductsResult, err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "ProductRepository", "ListProducts")
+	defer end(&err)
+	return r.next.ListProducts(ctx, listOptions)
+}
+
+func (r *productRepository) CreateProduct(ctx context.Context, product *models.Product) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "ProductRepository", "CreateProduct")
+	defer end(&err)
+	return r.next.CreateProduct(ctx, product)
+}
+
+func (r *productRepository) UpdateProduct(ctx context.Context, product *models.Product) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "ProductRepository", "UpdateProduct")
+	defer end(&err)
+	return r.next.UpdateProduct(ctx, product)
+}
+
+func (r *productRepository) DeleteProduct(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, end := startRepositorySpan(ctx, r.tracer, "ProductRepository", "DeleteProduct")
+	defer end(&err)
+	return r.next.DeleteProduct(ctx, id)
+}
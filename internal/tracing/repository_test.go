@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"product-services/internal/mocks"
+	"product-services/internal/models"
+	"product-services/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrumentCategoryRepository(t *testing.T) {
+	t.Run("should create a child span per call", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+		category := &models.Category{ID: uuid.New()}
+		mockRepo := new(mocks.MockCategoryRepository)
+		mockRepo.On("GetCategoryByID", mock.Anything, category.ID).Return(category, nil)
+		repo := InstrumentCategoryRepository(mockRepo, tp)
+
+		got, err := repo.GetCategoryByID(ctx, category.ID)
+		require.NoError(t, err)
+		assert.Equal(t, category, got)
+		parent.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		span := spans[0]
+		assert.Equal(t, "CategoryRepository.GetCategoryByID", span.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInstrumentProductRepository(t *testing.T) {
+	t.Run("should record the error of a failed call", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		id := uuid.New()
+		mockRepo := new(mocks.MockProductRepository)
+		mockRepo.On("DeleteProduct", mock.Anything, id).
+			Return(shared.Errorf(shared.KindNotFound, "product `%s` does not exist", id))
+		repo := InstrumentProductRepository(mockRepo, tp)
+
+		err := repo.DeleteProduct(context.Background(), id)
+		assert.ErrorIs(t, err, shared.ErrNotFound)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "ProductRepository.DeleteProduct", span.Name())
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Contains(t, span.Attributes(), attribute.String("error.kind", shared.KindNotFound.String()))
+		require.Len(t, span.Events(), 1)
+		assert.Equal(t, "exception", span.Events()[0].Name)
+		mockRepo.AssertExpectations(t)
+	})
+}
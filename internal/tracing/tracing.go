@@ -0,0 +1,135 @@
+// Package tracing sets up OpenTelemetry tracing: the tracer provider and its
+// exporter, the W3C trace context propagator and the repository decorators
+// creating a span per call.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerName is the instrumentation scope of the spans created by the
+// service.
+const TracerName = "product-services"
+
+// Exporter selects where the finished spans are sent.
+type Exporter string
+
+const (
+	// ExporterNone disables tracing.
+	ExporterNone Exporter = "none"
+	// ExporterStdout writes the spans as JSON to stdout or to a file.
+	ExporterStdout Exporter = "stdout"
+	// ExporterOTLP sends the spans to an OTLP/HTTP collector configured
+	// through the standard OTEL_EXPORTER_OTLP_* variables.
+	ExporterOTLP Exporter = "otlp"
+)
+
+// ParseExporter parses the name of a trace exporter.
+func ParseExporter(s string) (Exporter, error) {
+	switch exporter := Exporter(s); exporter {
+	case ExporterNone, ExporterStdout, ExporterOTLP:
+		return exporter, nil
+	default:
+		return "", fmt.Errorf("unsupported trace exporter: `%s`, must be none, stdout or otlp", s)
+	}
+}
+
+// Config configures the tracer provider returned by Setup.
+type Config struct {
+	ServiceName string
+	Exporter    Exporter
+	// FilePath is the file the stdout exporter appends the spans to. Empty
+	// writes them to stdout.
+	FilePath string
+}
+
+// Setup installs the W3C trace context propagator and a tracer provider
+// exporting spans as configured by cfg as the global ones, and returns the
+// provider together with the function flushing and stopping it.
+func Setup(ctx context.Context, cfg Config) (trace.TracerProvider, func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.Exporter == ExporterNone || cfg.Exporter == "" {
+		provider := noop.NewTracerProvider()
+		otel.SetTracerProvider(provider)
+		return provider, func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeOutput, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	shutdown := func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeErr := closeOutput(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+	return provider, shutdown, nil
+}
+
+// newExporter returns the exporter selected by cfg and the function closing
+// the file it writes to, if any.
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+
+	switch cfg.Exporter {
+	case ExporterStdout:
+		var out io.Writer = os.Stdout
+		closeOutput := noClose
+		if cfg.FilePath != "" {
+			file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to open trace file: `%s`, error: %w", cfg.FilePath, err)
+			}
+			out, closeOutput = file, file.Close
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(out))
+		if err != nil {
+			_ = closeOutput()
+			return nil, nil, fmt.Errorf("failed to create stdout trace exporter: %w", err)
+		}
+		return exporter, closeOutput, nil
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create otlp trace exporter: %w", err)
+		}
+		return exporter, noClose, nil
+	default:
+		_, err := ParseExporter(string(cfg.Exporter))
+		return nil, nil, err
+	}
+}
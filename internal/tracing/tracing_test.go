@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExporter(t *testing.T) {
+	for _, name := range []string{"none", "stdout", "otlp"} {
+		exporter, err := ParseExporter(name)
+		require.NoError(t, err)
+		assert.Equal(t, Exporter(name), exporter)
+	}
+
+	_, err := ParseExporter("jaeger")
+	assert.EqualError(t, err, "unsupported trace exporter: `jaeger`, must be none, stdout or otlp")
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("should write the spans to the trace file on shutdown", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "traces.json")
+		tp, shutdown, err := Setup(context.Background(), Config{
+			ServiceName: "ProductService",
+			Exporter:    ExporterStdout,
+			FilePath:    path,
+		})
+		require.NoError(t, err)
+
+		_, span := tp.Tracer(TracerName).Start(context.Background(), "GET /products")
+		span.End()
+		require.NoError(t, shutdown(context.Background()))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"Name":"GET /products"`)
+		assert.Contains(t, string(data), span.SpanContext().TraceID().String())
+		assert.Contains(t, string(data), `"ProductService"`)
+	})
+
+	t.Run("should disable tracing with the none exporter", func(t *testing.T) {
+		tp, shutdown, err := Setup(context.Background(), Config{Exporter: ExporterNone})
+		require.NoError(t, err)
+
+		_, span := tp.Tracer(TracerName).Start(context.Background(), "GET /products")
+		assert.False(t, span.SpanContext().IsValid())
+		assert.NoError(t, shutdown(context.Background()))
+	})
+}
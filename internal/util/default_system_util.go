@@ -0,0 +1,36 @@
+package util
+
+import (
+	"time"
+
+	"product-services/internal/interfaces"
+
+	"github.com/google/uuid"
+)
+
+type DefaultSystemUtil struct{}
+
+func NewSystemUtil() interfaces.SystemUtil {
+	return &DefaultSystemUtil{}
+}
+
+// CurrentTime returns the current time in UTC.
+func (u *DefaultSystemUtil) CurrentTime() time.Time {
+	return time.Now().UTC()
+}
+
+// Now returns the current local time, which keeps the monotonic clock
+// reading that CurrentTime strips.
+func (u *DefaultSystemUtil) Now() time.Time {
+	return time.Now()
+}
+
+// Since returns the time elapsed since start on the monotonic clock.
+func (u *DefaultSystemUtil) Since(start time.Time) time.Duration {
+	return time.Since(start)
+}
+
+// NewUUID returns a new random (version 4) UUID.
+func (u *DefaultSystemUtil) NewUUID() uuid.UUID {
+	return uuid.New()
+}